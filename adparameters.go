@@ -0,0 +1,416 @@
+package vast
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParametersFormat is the format of the data of AdParameters.
+type ParametersFormat string
+
+const (
+	// A JSON object or array, e.g. {"ad_id":"123"}
+	ParametersFormatJSON ParametersFormat = "json"
+	// URL-encoded key/values, e.g. ad_id=123&title=ad
+	ParametersFormatQuery ParametersFormat = "query"
+	// An XML fragment, e.g. <ad_id>123</ad_id>
+	ParametersFormatXML ParametersFormat = "xml"
+	// Unstructured text
+	ParametersFormatText ParametersFormat = "text"
+)
+
+// errParametersText is returned when decoding unstructured parameters.
+var errParametersText = errors.New("vast: ad parameters are not JSON, URL-encoded or XML")
+
+// errParametersArray is returned by Map for JSON arrays, which have no keys.
+var errParametersArray = errors.New("vast: ad parameters are a JSON array, use Decode")
+
+// Format detects the format of the parameters, decoded from their xmlEncoded
+// form if needed.
+func (p *AdParameters) Format() ParametersFormat {
+	return parametersFormat(strings.TrimSpace(p.DecodedParameters()))
+}
+
+func parametersFormat(s string) ParametersFormat {
+	switch {
+	case s == "":
+		return ParametersFormatText
+	case (s[0] == '{' || s[0] == '[') && json.Valid([]byte(s)):
+		return ParametersFormatJSON
+	case s[0] == '<' && validXML(s):
+		return ParametersFormatXML
+	case strings.Contains(s, "=") && !strings.ContainsAny(s, " \t\r\n<>{}\""):
+		if _, err := url.ParseQuery(s); err == nil {
+			return ParametersFormatQuery
+		}
+	}
+	return ParametersFormatText
+}
+
+func validXML(s string) bool {
+	d := xml.NewDecoder(strings.NewReader(s))
+	for {
+		if _, err := d.Token(); err != nil {
+			return err == io.EOF
+		}
+	}
+}
+
+// Map decodes the parameters into a generic map:
+//
+//   - JSON objects are decoded as with encoding/json, while JSON arrays
+//     cannot be decoded into a map: an error is returned, Decode should be
+//     used instead
+//   - URL-encoded values are strings, or []interface{} of strings for the
+//     repeated keys
+//   - XML elements are keyed by their name, their value being their text, or
+//     a map of their attributes, prefixed with "@", and children elements.
+//     Repeated elements are gathered in a []interface{}.
+func (p *AdParameters) Map() (map[string]interface{}, error) {
+	s := strings.TrimSpace(p.DecodedParameters())
+	switch parametersFormat(s) {
+	case ParametersFormatJSON:
+		if s[0] == '[' {
+			return nil, errParametersArray
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case ParametersFormatQuery:
+		values, err := url.ParseQuery(s)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]interface{}, len(values))
+		for k, vs := range values {
+			if len(vs) == 1 {
+				m[k] = vs[0]
+				continue
+			}
+			l := make([]interface{}, len(vs))
+			for i, v := range vs {
+				l[i] = v
+			}
+			m[k] = l
+		}
+		return m, nil
+	case ParametersFormatXML:
+		m := map[string]interface{}{}
+		d := xml.NewDecoder(strings.NewReader(s))
+		for {
+			t, err := d.Token()
+			if err == io.EOF {
+				return m, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			if start, ok := t.(xml.StartElement); ok {
+				v, err := xmlValue(d, start)
+				if err != nil {
+					return nil, err
+				}
+				addValue(m, start.Name.Local, v)
+			}
+		}
+	}
+	return nil, errParametersText
+}
+
+// xmlValue decodes an element into its text or a map of its attributes and
+// children.
+func xmlValue(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	m := map[string]interface{}{}
+	for _, a := range start.Attr {
+		m["@"+a.Name.Local] = a.Value
+	}
+	var text strings.Builder
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			v, err := xmlValue(d, t)
+			if err != nil {
+				return nil, err
+			}
+			addValue(m, t.Name.Local, v)
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if len(m) == 0 {
+				return strings.TrimSpace(text.String()), nil
+			}
+			return m, nil
+		}
+	}
+}
+
+func addValue(m map[string]interface{}, key string, v interface{}) {
+	switch prev := m[key].(type) {
+	case nil:
+		m[key] = v
+	case []interface{}:
+		m[key] = append(prev, v)
+	default:
+		m[key] = []interface{}{prev, v}
+	}
+}
+
+// Decode decodes the parameters into v: JSON with encoding/json, XML with
+// encoding/xml, and URL-encoded values as their Map would be decoded by
+// encoding/json, so that the fields of v are matched by their json tag. The
+// URL-encoded values of number and boolean fields are parsed, and a single
+// value is decoded as a list into a slice field.
+func (p *AdParameters) Decode(v interface{}) error {
+	s := strings.TrimSpace(p.DecodedParameters())
+	switch parametersFormat(s) {
+	case ParametersFormatJSON:
+		return json.Unmarshal([]byte(s), v)
+	case ParametersFormatXML:
+		return xml.Unmarshal([]byte(s), v)
+	case ParametersFormatQuery:
+		values, err := url.ParseQuery(s)
+		if err != nil {
+			return err
+		}
+		m := make(map[string]interface{}, len(values))
+		t := reflect.TypeOf(v)
+		for k, vs := range values {
+			if m[k], err = typedQueryValue(vs, queryFieldType(t, k)); err != nil {
+				return err
+			}
+		}
+		b, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(b, v)
+	}
+	return errParametersText
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// queryFieldType returns the type of the value a URL-encoded key is decoded
+// into by encoding/json, or nil if unknown.
+func queryFieldType(t reflect.Type, key string) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Map {
+		return t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	var fold reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			if ft := queryFieldType(f.Type, key); ft != nil {
+				return ft
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if name == key {
+			return f.Type
+		}
+		if fold == nil && strings.EqualFold(name, key) {
+			fold = f.Type
+		}
+	}
+	return fold
+}
+
+// typedQueryValue returns the URL-encoded values of a key as they are
+// decoded into a value of type t by encoding/json: a list for slices, and
+// the values parsed for numbers and booleans. When t is nil, the values are
+// strings as in Map.
+func typedQueryValue(vs []string, t reflect.Type) (interface{}, error) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() == reflect.Interface {
+		if len(vs) == 1 {
+			return vs[0], nil
+		}
+		t = nil
+	} else if k := t.Kind(); (k != reflect.Slice || t.Elem().Kind() == reflect.Uint8) && k != reflect.Array {
+		return typedQueryString(vs[0], t)
+	}
+	l := make([]interface{}, len(vs))
+	for i, s := range vs {
+		if t == nil {
+			l[i] = s
+			continue
+		}
+		v, err := typedQueryString(s, t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		l[i] = v
+	}
+	return l, nil
+}
+
+func typedQueryString(s string, t reflect.Type) (interface{}, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	p := reflect.PtrTo(t)
+	if p.Implements(jsonUnmarshalerType) || p.Implements(textUnmarshalerType) {
+		return s, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("vast: invalid boolean %q in ad parameters", s)
+		}
+		return b, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return nil, fmt.Errorf("vast: invalid number %q in ad parameters", s)
+		}
+		return json.Number(s), nil
+	}
+	return s, nil
+}
+
+// Encode replaces the parameters by v, encoded in their current format, or
+// as JSON when the parameters are empty or unstructured. The parameters stay
+// xmlEncoded if they were.
+//
+// URL-encoded values are encoded from a map or, for structs, from the object
+// encoding/json produces: the values which are neither strings, numbers or
+// booleans nor lists of those are JSON-encoded.
+func (p *AdParameters) Encode(v interface{}) error {
+	var s string
+	switch p.Format() {
+	case ParametersFormatXML:
+		b, err := xml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		s = string(b)
+	case ParametersFormatQuery:
+		var err error
+		if s, err = encodeQuery(v); err != nil {
+			return err
+		}
+	default:
+		var b bytes.Buffer
+		enc := json.NewEncoder(&b)
+		// keep the URLs readable
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		s = strings.TrimSuffix(b.String(), "\n")
+	}
+	p.SetParameters(s, p.XMLEncoded)
+	return nil
+}
+
+func encodeQuery(v interface{}) (string, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		switch vs := v.(type) {
+		case url.Values:
+			return vs.Encode(), nil
+		case map[string]string:
+			values := url.Values{}
+			for k, v := range vs {
+				values.Set(k, v)
+			}
+			return values.Encode(), nil
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		// keep the numbers as encoding/json wrote them
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber()
+		if err := d.Decode(&m); err != nil {
+			return "", fmt.Errorf("vast: cannot encode %T as URL-encoded ad parameters", v)
+		}
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := url.Values{}
+	for _, k := range keys {
+		if l, ok := m[k].([]interface{}); ok {
+			for _, e := range l {
+				s, err := queryValue(e)
+				if err != nil {
+					return "", err
+				}
+				values.Add(k, s)
+			}
+			continue
+		}
+		s, err := queryValue(m[k])
+		if err != nil {
+			return "", err
+		}
+		values.Set(k, s)
+	}
+	return values.Encode(), nil
+}
+
+func queryValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v), nil
+	}
+	b, err := json.Marshal(v)
+	return string(b), err
+}
@@ -0,0 +1,221 @@
+package vast
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdParametersFormat(t *testing.T) {
+	for params, format := range map[string]ParametersFormat{
+		`{"ad_id":"123"}`:             ParametersFormatJSON,
+		`  [1, 2]  `:                  ParametersFormatJSON,
+		`{"ad_id":`:                   ParametersFormatText,
+		`ad_id=123&title=an%20ad`:     ParametersFormatQuery,
+		`<params><id>1</id></params>`: ParametersFormatXML,
+		`<id>1</id><title>t</title>`:  ParametersFormatXML,
+		`<params>`:                    ParametersFormatText,
+		`some text`:                   ParametersFormatText,
+		`a = b`:                       ParametersFormatText,
+		``:                            ParametersFormatText,
+		`&lt;id&gt;1&lt;/id&gt;`:      ParametersFormatText,
+	} {
+		p := AdParameters{Parameters: params}
+		assert.Equal(t, format, p.Format(), params)
+	}
+	var p AdParameters
+	if assert.NoError(t, xml.Unmarshal([]byte(`<AdParameters xmlEncoded="true">&lt;id&gt;1&lt;/id&gt;</AdParameters>`), &p)) {
+		assert.Equal(t, ParametersFormatXML, p.Format())
+	}
+}
+
+type spotxParameters struct {
+	AdID  string `json:"ad_id"`
+	Title string `json:"title"`
+	Media struct {
+		Tracking struct {
+			Beacon []struct {
+				Type      string `json:"type"`
+				BeaconURL string `json:"beacon_url"`
+			} `json:"beacon"`
+		} `json:"tracking"`
+		Video []struct {
+			Playtime     int    `json:"playtime"`
+			MimeType     string `json:"mime_type"`
+			APIFramework string `json:"api_framework"`
+			MediaURL     string `json:"media_url"`
+		} `json:"video"`
+	} `json:"media"`
+}
+
+func TestAdParametersJSON(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/spotx_adparameters.txt")
+	if !assert.NoError(t, err) {
+		return
+	}
+	p := AdParameters{Parameters: string(b)}
+	assert.Equal(t, ParametersFormatJSON, p.Format())
+
+	m, err := p.Map()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1130507-1818483", m["ad_id"])
+		assert.Len(t, m["media"].(map[string]interface{})["video"], 3)
+	}
+
+	var params spotxParameters
+	if !assert.NoError(t, p.Decode(&params)) {
+		return
+	}
+	assert.Equal(t, "IntegralAds_VAST_2_0_Ad_Wrapper", params.Title)
+	assert.Len(t, params.Media.Tracking.Beacon, 27)
+	assert.Equal(t, "skip", params.Media.Tracking.Beacon[0].Type)
+	if assert.Len(t, params.Media.Video, 3) {
+		assert.Equal(t, 16, params.Media.Video[0].Playtime)
+		assert.Equal(t, "VPAID", params.Media.Video[0].APIFramework)
+	}
+
+	// modify and re-encode the generic map
+	m["title"] = "new title & more"
+	if assert.NoError(t, p.Encode(m)) {
+		m2, err := p.Map()
+		if assert.NoError(t, err) {
+			assert.Equal(t, m, m2)
+		}
+		assert.Contains(t, p.Parameters, `"title":"new title & more"`)
+	}
+
+	// xmlEncoded parameters stay xmlEncoded
+	p = AdParameters{XMLEncoded: true, Parameters: `{"id":1}`}
+	if assert.NoError(t, p.Encode(map[string]int{"id": 2})) {
+		assert.True(t, p.XMLEncoded)
+		assert.Equal(t, `{"id":2}`, p.DecodedParameters())
+		out, _ := xml.Marshal(p)
+		assert.Equal(t, `<AdParameters xmlEncoded="true">{&#34;id&#34;:2}</AdParameters>`, string(out))
+	}
+}
+
+func TestAdParametersQuery(t *testing.T) {
+	p := AdParameters{Parameters: "ad_id=123&title=an+ad&tracker=a&tracker=b"}
+	m, err := p.Map()
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{
+			"ad_id":   "123",
+			"title":   "an ad",
+			"tracker": []interface{}{"a", "b"},
+		}, m)
+	}
+
+	var params struct {
+		AdID     string   `json:"ad_id"`
+		Title    string   `json:"title"`
+		Trackers []string `json:"tracker"`
+	}
+	if assert.NoError(t, p.Decode(&params)) {
+		assert.Equal(t, "123", params.AdID)
+		assert.Equal(t, "an ad", params.Title)
+		assert.Equal(t, []string{"a", "b"}, params.Trackers)
+	}
+
+	params.Title = "other ad"
+	if assert.NoError(t, p.Encode(params)) {
+		assert.Equal(t, "ad_id=123&title=other+ad&tracker=a&tracker=b", p.Parameters)
+	}
+	if assert.NoError(t, p.Encode(map[string]interface{}{"n": 1.5, "ok": true, "obj": map[string]int{"a": 1}})) {
+		assert.Equal(t, "n=1.5&obj=%7B%22a%22%3A1%7D&ok=true", p.Parameters)
+	}
+	if assert.NoError(t, p.Encode(map[string]interface{}{"big": 12345678.0, "small": 0.000001})) {
+		assert.Equal(t, "big=12345678&small=0.000001", p.Parameters)
+	}
+
+	// numbers and booleans
+	type typed struct {
+		ID      int       `json:"id"`
+		Price   float64   `json:"price"`
+		Live    bool      `json:"live"`
+		Sizes   []uint    `json:"size"`
+		Skip    *Duration `json:"skip"`
+		Comment string    `json:"comment"`
+	}
+	if assert.NoError(t, p.Encode(typed{ID: 12345678, Price: 0.5, Sizes: []uint{1}, Comment: "12"})) {
+		assert.Equal(t, "comment=12&id=12345678&live=false&price=0.5&size=1&skip=", p.Parameters)
+	}
+	p = AdParameters{Parameters: "id=12345678&price=1.5e2&live=true&size=1&skip=00:00:05&comment=12"}
+	var params2 typed
+	if assert.NoError(t, p.Decode(&params2)) {
+		assert.Equal(t, typed{ID: 12345678, Price: 150, Live: true, Sizes: []uint{1}, Skip: params2.Skip, Comment: "12"}, params2)
+		if assert.NotNil(t, params2.Skip) {
+			assert.Equal(t, Duration(5*time.Second), *params2.Skip)
+		}
+	}
+	var m2 map[string]int
+	if assert.NoError(t, (&AdParameters{Parameters: "a=1&b=2"}).Decode(&m2)) {
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, m2)
+	}
+	for _, params := range []string{"id=x", "live=maybe"} {
+		p = AdParameters{Parameters: params}
+		assert.Error(t, p.Decode(&params2), params)
+	}
+}
+
+func TestAdParametersXML(t *testing.T) {
+	p := AdParameters{Parameters: `<params version="2"><id>1</id><tracker>a</tracker><tracker>b</tracker><media><url>https://example.com/ad.mp4</url></media></params>`}
+	m, err := p.Map()
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{
+			"params": map[string]interface{}{
+				"@version": "2",
+				"id":       "1",
+				"tracker":  []interface{}{"a", "b"},
+				"media":    map[string]interface{}{"url": "https://example.com/ad.mp4"},
+			},
+		}, m)
+	}
+
+	var params struct {
+		XMLName  xml.Name `xml:"params"`
+		Version  string   `xml:"version,attr"`
+		ID       string   `xml:"id"`
+		Trackers []string `xml:"tracker"`
+		MediaURL string   `xml:"media>url"`
+	}
+	if assert.NoError(t, p.Decode(&params)) {
+		assert.Equal(t, "2", params.Version)
+		assert.Equal(t, []string{"a", "b"}, params.Trackers)
+		assert.Equal(t, "https://example.com/ad.mp4", params.MediaURL)
+	}
+
+	params.ID = "2"
+	if assert.NoError(t, p.Encode(params)) {
+		assert.Equal(t, `<params version="2"><id>2</id><tracker>a</tracker><tracker>b</tracker><media><url>https://example.com/ad.mp4</url></media></params>`, p.Parameters)
+	}
+}
+
+func TestAdParametersJSONArray(t *testing.T) {
+	p := AdParameters{Parameters: ` [{"id":1},{"id":2}]`}
+	assert.Equal(t, ParametersFormatJSON, p.Format())
+	_, err := p.Map()
+	assert.EqualError(t, err, "vast: ad parameters are a JSON array, use Decode")
+
+	var v []struct {
+		ID int `json:"id"`
+	}
+	if assert.NoError(t, p.Decode(&v)) && assert.Len(t, v, 2) {
+		assert.Equal(t, 2, v[1].ID)
+	}
+}
+
+func TestAdParametersText(t *testing.T) {
+	p := AdParameters{Parameters: "some text"}
+	_, err := p.Map()
+	assert.Error(t, err)
+	var v map[string]interface{}
+	assert.Error(t, p.Decode(&v))
+
+	// unstructured parameters are replaced by JSON
+	if assert.NoError(t, p.Encode(map[string]string{"a": "<b>"})) {
+		assert.Equal(t, `{"a":"<b>"}`, p.Parameters)
+	}
+}
@@ -0,0 +1,313 @@
+package vast
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TagResponse is the raw response of an ad tag request.
+type TagResponse struct {
+	// The body of the response, expected to be a VAST document
+	Body []byte
+	// The HTTP headers of the response, if any
+	Header http.Header
+}
+
+// Fetcher retrieves the response of an ad tag, typically a VASTAdTagURI.
+type Fetcher interface {
+	Fetch(ctx context.Context, uri string) (*TagResponse, error)
+}
+
+// HTTPFetcher is a Fetcher requesting ad tags over HTTP.
+type HTTPFetcher struct {
+	// The client used to perform the requests. http.DefaultClient is used
+	// when nil.
+	Client *http.Client
+}
+
+// Fetch implements the Fetcher interface.
+func (f HTTPFetcher) Fetch(ctx context.Context, uri string) (*TagResponse, error) {
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching %s: %s", uri, resp.Status)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &TagResponse{Body: b, Header: resp.Header}, nil
+}
+
+// CacheEntry is a decoded VAST document stored in a cache backend.
+type CacheEntry struct {
+	VAST *VAST
+	// The time after which the entry must not be served anymore
+	ExpiresAt time.Time
+}
+
+// CacheBackend is the storage used by a Cache.
+type CacheBackend interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, e CacheEntry)
+	Delete(key string)
+}
+
+// Cache stores decoded VAST documents keyed by their normalized ad tag URL.
+//
+// The time an entry is kept is the smallest of the InLine <Expires> values
+// and the HTTP caching headers of the response. Documents returned by the
+// cache are shared and must not be modified.
+type Cache struct {
+	// The storage of the entries
+	Backend CacheBackend
+	// Used when neither the document nor the response headers define how long
+	// an entry may be cached. Zero disables caching of those responses.
+	DefaultTTL time.Duration
+	// Returns the current time, time.Now is used when nil
+	Now func() time.Time
+}
+
+// NewCache returns a Cache backed by an in-memory LRU of the given size.
+func NewCache(size int) *Cache {
+	return &Cache{Backend: NewLRUCache(size)}
+}
+
+// Get returns the cached document of an ad tag, if any.
+func (c *Cache) Get(uri string) (*VAST, bool) {
+	key := NormalizeAdTagURI(uri)
+	e, ok := c.Backend.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.ExpiresAt) {
+		c.Backend.Delete(key)
+		return nil, false
+	}
+	return e.VAST, true
+}
+
+// Set caches the document of an ad tag for the given duration.
+func (c *Cache) Set(uri string, v *VAST, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.Backend.Set(NormalizeAdTagURI(uri), CacheEntry{VAST: v, ExpiresAt: c.now().Add(ttl)})
+}
+
+// Load returns the document of an ad tag from the cache or, when missing or
+// expired, fetches it with f, decodes it and caches it.
+func (c *Cache) Load(ctx context.Context, f Fetcher, uri string) (*VAST, error) {
+	if v, ok := c.Get(uri); ok {
+		return v, nil
+	}
+	resp, err := f.Fetch(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	var v VAST
+	if err := Unmarshal(resp.Body, &v); err != nil {
+		return nil, err
+	}
+	ttl, ok := CacheTTL(&v, resp.Header, c.now())
+	if !ok {
+		ttl = c.DefaultTTL
+	}
+	c.Set(uri, &v, ttl)
+	return &v, nil
+}
+
+func (c *Cache) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+// CacheTTL returns how long a document may be cached according to the
+// <Expires> of its InLine ads and the Cache-Control and Expires headers of
+// its response. The boolean is false when none of them is defined.
+func CacheTTL(v *VAST, h http.Header, now time.Time) (time.Duration, bool) {
+	var ttl time.Duration
+	found := false
+	set := func(d time.Duration) {
+		if !found || d < ttl {
+			ttl = d
+		}
+		found = true
+	}
+	for _, ad := range v.Ads {
+		if ad.InLine != nil && ad.InLine.Expires > 0 {
+			set(time.Duration(ad.InLine.Expires) * time.Second)
+		}
+	}
+	if d, ok := headerTTL(h, now); ok {
+		set(d)
+	}
+	if ttl < 0 {
+		ttl = 0
+	}
+	return ttl, found
+}
+
+// headerTTL returns the freshness lifetime defined by the HTTP headers.
+func headerTTL(h http.Header, now time.Time) (time.Duration, bool) {
+	if h == nil {
+		return 0, false
+	}
+	if cc := h.Get("Cache-Control"); cc != "" {
+		maxAge := -1
+		for _, d := range strings.Split(cc, ",") {
+			d = strings.ToLower(strings.TrimSpace(d))
+			switch {
+			case d == "no-store" || d == "no-cache" || d == "private":
+				return 0, true
+			case strings.HasPrefix(d, "s-maxage="):
+				// s-maxage overrides max-age for shared caches
+				if n, err := strconv.Atoi(d[len("s-maxage="):]); err == nil {
+					return time.Duration(n) * time.Second, true
+				}
+			case strings.HasPrefix(d, "max-age="):
+				if n, err := strconv.Atoi(d[len("max-age="):]); err == nil {
+					maxAge = n
+				}
+			}
+		}
+		if maxAge >= 0 {
+			return time.Duration(maxAge) * time.Second, true
+		}
+	}
+	if exp := h.Get("Expires"); exp != "" {
+		t, err := http.ParseTime(exp)
+		if err != nil {
+			// an invalid date means the response is already expired
+			return 0, true
+		}
+		return t.Sub(now), true
+	}
+	return 0, false
+}
+
+// macroPattern matches the macro notations found in ad tags: [MACRO],
+// __MACRO__, %%MACRO%%, ${MACRO} and {MACRO}, in raw or URL-encoded form.
+var macroPattern = regexp.MustCompile(`(?i)\[[A-Z0-9_.]+\]|%5B[A-Z0-9_.]+%5D|__[A-Z0-9_]+__|%%[A-Z0-9_]+%%|\$\{[A-Z0-9_.]+\}|\{[A-Z0-9_.]+\}|%7B[A-Z0-9_.]+%7D`)
+
+// NormalizeAdTagURI returns the cache key of an ad tag URL: macros are
+// removed, the query parameters which only held macros are dropped, the
+// remaining ones are sorted and the scheme and host are lower cased.
+func NormalizeAdTagURI(uri string) string {
+	uri = strings.TrimSpace(uri)
+	u, err := url.Parse(uri)
+	if err != nil {
+		return macroPattern.ReplaceAllString(uri, "")
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	u.Path = macroPattern.ReplaceAllString(u.Path, "")
+	u.RawPath = ""
+	var params []string
+	for _, p := range strings.Split(u.RawQuery, "&") {
+		if p == "" {
+			continue
+		}
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) == 2 {
+			v := macroPattern.ReplaceAllString(kv[1], "")
+			if v == "" && kv[1] != "" {
+				continue
+			}
+			p = kv[0] + "=" + v
+		}
+		params = append(params, p)
+	}
+	sort.Strings(params)
+	u.RawQuery = strings.Join(params, "&")
+	return u.String()
+}
+
+// LRUCache is an in-memory CacheBackend evicting the least recently used
+// entries once its size is reached. It is safe for concurrent use.
+type LRUCache struct {
+	size  int
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry CacheEntry
+}
+
+// NewLRUCache returns an LRUCache holding at most size entries.
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{size: size, ll: list.New(), items: make(map[string]*list.Element)}
+}
+
+// Get implements the CacheBackend interface.
+func (c *LRUCache) Get(key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*lruItem).entry, true
+}
+
+// Set implements the CacheBackend interface.
+func (c *LRUCache) Set(key string, e CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruItem).entry = e
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&lruItem{key: key, entry: e})
+	for c.size > 0 && c.ll.Len() > c.size {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*lruItem).key)
+	}
+}
+
+// Delete implements the CacheBackend interface.
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.ll.Remove(el)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
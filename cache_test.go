@@ -0,0 +1,112 @@
+package vast
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fetcherFunc func(ctx context.Context, uri string) (*TagResponse, error)
+
+func (f fetcherFunc) Fetch(ctx context.Context, uri string) (*TagResponse, error) {
+	return f(ctx, uri)
+}
+
+func TestInlineExpires(t *testing.T) {
+	v := VAST{Version: "4.1", Ads: []Ad{{InLine: &InLine{Expires: 3600}}}}
+	b, err := xml.Marshal(v)
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(b), "<Expires>3600</Expires>")
+	}
+}
+
+func TestNormalizeAdTagURI(t *testing.T) {
+	assert.Equal(t,
+		"http://ads.example.com/tag?a=1&b=2&ts=",
+		NormalizeAdTagURI("HTTP://Ads.Example.com/tag?b=2&cb=[CACHEBUSTING]&a=1&ts=#frag"))
+	assert.Equal(t,
+		NormalizeAdTagURI("http://ads.example.com/tag?id=1&cb=__RANDOM__&ts=%%TIMESTAMP%%"),
+		NormalizeAdTagURI("http://ads.example.com/tag?ts=${TIMESTAMP}&id=1&cb={random}"))
+	assert.Equal(t, "http://ads.example.com/tag?pos=pre-", NormalizeAdTagURI("http://ads.example.com/tag?pos=pre-%5BPOD%5D"))
+}
+
+func TestCacheTTL(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	v := &VAST{Ads: []Ad{
+		{InLine: &InLine{Expires: 600}},
+		{InLine: &InLine{Expires: 300}},
+		{Wrapper: &Wrapper{}},
+	}}
+
+	ttl, ok := CacheTTL(v, nil, now)
+	assert.True(t, ok)
+	assert.Equal(t, 300*time.Second, ttl)
+
+	ttl, ok = CacheTTL(v, http.Header{"Cache-Control": {"public, max-age=60"}}, now)
+	assert.True(t, ok)
+	assert.Equal(t, 60*time.Second, ttl)
+
+	ttl, ok = CacheTTL(v, http.Header{"Cache-Control": {"max-age=60, s-maxage=30"}}, now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, ttl)
+
+	ttl, ok = CacheTTL(v, http.Header{"Cache-Control": {"no-store"}}, now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), ttl)
+
+	ttl, ok = CacheTTL(&VAST{}, http.Header{"Expires": {"Wed, 01 Jan 2020 00:02:00 GMT"}}, now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, ttl)
+
+	_, ok = CacheTTL(&VAST{}, http.Header{}, now)
+	assert.False(t, ok)
+}
+
+func TestCacheLoad(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewCache(10)
+	c.Now = func() time.Time { return now }
+
+	calls := 0
+	f := fetcherFunc(func(ctx context.Context, uri string) (*TagResponse, error) {
+		calls++
+		return &TagResponse{
+			Body: []byte(`<VAST version="4.1"><Ad id="1"><InLine><Expires>60</Expires></InLine></Ad></VAST>`),
+		}, nil
+	})
+
+	v, err := c.Load(context.Background(), f, "http://ads.example.com/tag?cb=[CACHEBUSTING]")
+	if assert.NoError(t, err) && assert.Len(t, v.Ads, 1) {
+		assert.Equal(t, 60, v.Ads[0].InLine.Expires)
+	}
+	v2, err := c.Load(context.Background(), f, "http://ads.example.com/tag?cb=[CACHEBUSTING]")
+	assert.NoError(t, err)
+	assert.True(t, v == v2)
+	assert.Equal(t, 1, calls)
+
+	now = now.Add(time.Minute)
+	_, err = c.Load(context.Background(), f, "http://ads.example.com/tag?cb=[CACHEBUSTING]")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestLRUCache(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("a", CacheEntry{})
+	c.Set("b", CacheEntry{})
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+	c.Set("c", CacheEntry{})
+	assert.Equal(t, 2, c.Len())
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	_, ok = c.Get("a")
+	assert.True(t, ok)
+	c.Delete("a")
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+}
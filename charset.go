@@ -0,0 +1,170 @@
+package vast
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// Decode reads a VAST document from r. Unlike xml.Unmarshal, documents which
+// are not encoded in UTF-8 are supported: the encoding is detected from the
+// byte order mark or the XML declaration, e.g. ISO-8859-1, Windows-1252 or
+// UTF-16, and the content converted to UTF-8.
+func Decode(r io.Reader) (*VAST, error) {
+	var v VAST
+	if err := newXMLDecoder(r).Decode(&v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// Unmarshal parses a VAST document whatever its encoding, see Decode.
+func Unmarshal(data []byte, v *VAST) error {
+	return newXMLDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// DecodeStrict reads a VAST document from r as Decode does, but fails when
+// the event of a Tracking element is invalid, see EventType.Validate.
+func DecodeStrict(r io.Reader) (*VAST, error) {
+	ur, err := newUTF8Reader(r)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(ur)
+	if err != nil {
+		return nil, err
+	}
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.CharsetReader = utf8CharsetReader
+	var v VAST
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if err := validateEvents(data); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UnmarshalStrict parses a VAST document strictly, see DecodeStrict.
+func UnmarshalStrict(data []byte, v *VAST) error {
+	res, err := DecodeStrict(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	*v = *res
+	return nil
+}
+
+// validateEvents validates the events of the Tracking elements of a document
+// converted to UTF-8.
+func validateEvents(data []byte) error {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.CharsetReader = utf8CharsetReader
+	for {
+		t, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		start, ok := t.(xml.StartElement)
+		if !ok || start.Name.Local != "Tracking" {
+			continue
+		}
+		for _, a := range start.Attr {
+			if a.Name.Local == "event" {
+				if err := EventType(a.Value).Validate(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// newXMLDecoder returns an xml.Decoder reading r converted to UTF-8.
+func newXMLDecoder(r io.Reader) *xml.Decoder {
+	ur, err := newUTF8Reader(r)
+	if err != nil {
+		ur = &errReader{err}
+	}
+	dec := xml.NewDecoder(ur)
+	dec.CharsetReader = utf8CharsetReader
+	return dec
+}
+
+// utf8CharsetReader is the CharsetReader of decoders reading the output of
+// newUTF8Reader: the content is already converted, the declared encoding can
+// be ignored.
+func utf8CharsetReader(label string, input io.Reader) (io.Reader, error) {
+	return input, nil
+}
+
+// sniffLen is the number of bytes inspected to detect the encoding.
+const sniffLen = 1024
+
+// encodingDecl matches the encoding of an XML declaration.
+var encodingDecl = regexp.MustCompile(`^\s*<\?xml[^>]*?\sencoding\s*=\s*["']([A-Za-z0-9._:-]+)["']`)
+
+// newUTF8Reader returns a reader converting the content of r to UTF-8. The
+// encoding is detected from the byte order mark, the layout of the first
+// bytes for UTF-16 documents without byte order mark, or the XML declaration.
+func newUTF8Reader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReaderSize(r, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+
+	var enc encoding.Encoding
+	switch {
+	case bytes.HasPrefix(head, []byte{0xEF, 0xBB, 0xBF}):
+		// UTF-8 byte order mark
+		br.Discard(3)
+		return br, nil
+	case bytes.HasPrefix(head, []byte{0xFF, 0xFE}):
+		enc = unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM)
+	case bytes.HasPrefix(head, []byte{0xFE, 0xFF}):
+		enc = unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM)
+	case bytes.HasPrefix(head, []byte{'<', 0, '?', 0}):
+		enc = unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case bytes.HasPrefix(head, []byte{0, '<', 0, '?'}):
+		enc = unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	default:
+		m := encodingDecl.FindSubmatch(head)
+		if m == nil {
+			return br, nil
+		}
+		label := strings.ToLower(string(m[1]))
+		// the content was not detected as UTF-16, a declaration saying
+		// otherwise is wrong.
+		if label == "utf-8" || label == "utf8" || label == "us-ascii" || label == "ascii" || strings.HasPrefix(label, "utf-16") {
+			return br, nil
+		}
+		enc, err = htmlindex.Get(label)
+		if err != nil {
+			return nil, fmt.Errorf("vast: unsupported encoding %q", m[1])
+		}
+	}
+	return transform.NewReader(br, enc.NewDecoder()), nil
+}
+
+// errReader is a reader failing with err.
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
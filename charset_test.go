@@ -0,0 +1,76 @@
+package vast
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func encodeVAST(t *testing.T, enc encoding.Encoding, decl string, title string) []byte {
+	doc := `<?xml version="1.0"` + decl + `?><VAST version="3.0"><Ad id="1"><InLine><AdTitle><![CDATA[` + title + `]]></AdTitle></InLine></Ad></VAST>`
+	if enc == nil {
+		return []byte(doc)
+	}
+	b, err := enc.NewEncoder().Bytes([]byte(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestDecodeCharsets(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  []byte
+		title string
+	}{
+		{"UTF-8", encodeVAST(t, nil, ` encoding="UTF-8"`, "Crème brûlée"), "Crème brûlée"},
+		{"UTF-8 without declaration", encodeVAST(t, nil, "", "Crème brûlée"), "Crème brûlée"},
+		{"UTF-8 BOM", append([]byte{0xEF, 0xBB, 0xBF}, encodeVAST(t, nil, "", "Crème brûlée")...), "Crème brûlée"},
+		{"ISO-8859-1", encodeVAST(t, charmap.ISO8859_1, ` encoding="ISO-8859-1"`, "Crème brûlée"), "Crème brûlée"},
+		{"latin1", encodeVAST(t, charmap.ISO8859_1, ` encoding='latin1'`, "Grüße"), "Grüße"},
+		{"Windows-1252", encodeVAST(t, charmap.Windows1252, ` encoding="windows-1252"`, "Only 5€ – “today”"), "Only 5€ – “today”"},
+		{"Windows-1251", encodeVAST(t, charmap.Windows1251, ` encoding="Windows-1251"`, "Реклама"), "Реклама"},
+		{"Windows-1250", encodeVAST(t, charmap.Windows1250, ` encoding="windows-1250"`, "Zażółć gęślą jaźń"), "Zażółć gęślą jaźń"},
+		{"UTF-16LE BOM", encodeVAST(t, unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), ` encoding="UTF-16"`, "広告"), "広告"},
+		{"UTF-16BE BOM", encodeVAST(t, unicode.UTF16(unicode.BigEndian, unicode.UseBOM), ` encoding="UTF-16"`, "広告"), "広告"},
+		{"UTF-16LE", encodeVAST(t, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), ` encoding="UTF-16LE"`, "Crème"), "Crème"},
+		{"UTF-16BE", encodeVAST(t, unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), ` encoding="UTF-16BE"`, "Crème"), "Crème"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Decode(bytes.NewReader(tt.data))
+			if assert.NoError(t, err) && assert.Len(t, v.Ads, 1) {
+				assert.Equal(t, tt.title, v.Ads[0].InLine.AdTitle.CDATA)
+			}
+
+			var v2 VAST
+			if assert.NoError(t, Unmarshal(tt.data, &v2)) {
+				assert.Equal(t, v, &v2)
+			}
+
+			d := NewAdDecoder(bytes.NewReader(tt.data))
+			ad, err := d.Next()
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.title, ad.InLine.AdTitle.CDATA)
+			}
+			_, err = d.Next()
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
+
+func TestDecodeCharsetErrors(t *testing.T) {
+	data := encodeVAST(t, charmap.ISO8859_1, ` encoding="ISO-8859-1"`, "Crème")
+	var v VAST
+	assert.EqualError(t, xml.Unmarshal(data, &v), `xml: encoding "ISO-8859-1" declared but Decoder.CharsetReader is nil`)
+
+	_, err := Decode(bytes.NewReader(encodeVAST(t, nil, ` encoding="x-unknown"`, "title")))
+	assert.EqualError(t, err, `vast: unsupported encoding "x-unknown"`)
+}
@@ -0,0 +1,411 @@
+package vast
+
+// Clone returns a deep copy of the document: the copy shares no pointer,
+// slice or map with v, so it can be modified without affecting v, e.g. to
+// rewrite a shared template for a single request.
+//
+// Nil and empty slices are preserved as such.
+func (v *VAST) Clone() *VAST {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	c.Errors = cloneCDATAs(v.Errors)
+	if v.Ads != nil {
+		c.Ads = make([]Ad, len(v.Ads))
+		for i := range v.Ads {
+			c.Ads[i] = v.Ads[i].clone()
+		}
+	}
+	return &c
+}
+
+// Clone returns a deep copy of the ad.
+func (ad *Ad) Clone() *Ad {
+	if ad == nil {
+		return nil
+	}
+	c := ad.clone()
+	return &c
+}
+
+func (ad *Ad) clone() Ad {
+	c := *ad
+	c.InLine = ad.InLine.Clone()
+	c.Wrapper = ad.Wrapper.Clone()
+	return c
+}
+
+// Clone returns a deep copy of the inline ad.
+func (inline *InLine) Clone() *InLine {
+	if inline == nil {
+		return nil
+	}
+	c := *inline
+	c.AdSystem = cloneAdSystem(inline.AdSystem)
+	c.Errors = cloneCDATAs(inline.Errors)
+	c.Extensions = cloneExtensionList(inline.Extensions)
+	c.Impressions = cloneImpressions(inline.Impressions)
+	c.Pricing = clonePricing(inline.Pricing)
+	if inline.Categories != nil {
+		c.Categories = append([]Category(nil), inline.Categories...)
+	}
+	if inline.Creatives != nil {
+		c.Creatives = make([]Creative, len(inline.Creatives))
+		for i := range inline.Creatives {
+			c.Creatives[i] = inline.Creatives[i].clone()
+		}
+	}
+	c.Description = cloneCDATA(inline.Description)
+	c.Survey = cloneCDATA(inline.Survey)
+	return &c
+}
+
+// Clone returns a deep copy of the wrapper.
+func (w *Wrapper) Clone() *Wrapper {
+	if w == nil {
+		return nil
+	}
+	c := *w
+	c.AdSystem = cloneAdSystem(w.AdSystem)
+	c.Errors = cloneCDATAs(w.Errors)
+	c.Extensions = cloneExtensions(w.Extensions)
+	c.Impressions = cloneImpressions(w.Impressions)
+	c.Pricing = clonePricing(w.Pricing)
+	if w.Creatives != nil {
+		c.Creatives = make([]CreativeWrapper, len(w.Creatives))
+		for i := range w.Creatives {
+			c.Creatives[i] = w.Creatives[i].clone()
+		}
+	}
+	c.FallbackOnNoAd = cloneBool(w.FallbackOnNoAd)
+	c.AllowMultipleAds = cloneBool(w.AllowMultipleAds)
+	c.FollowAdditionalWrappers = cloneBool(w.FollowAdditionalWrappers)
+	return &c
+}
+
+// Clone returns a deep copy of the creative.
+func (cr *Creative) Clone() *Creative {
+	if cr == nil {
+		return nil
+	}
+	c := cr.clone()
+	return &c
+}
+
+func (cr *Creative) clone() Creative {
+	c := *cr
+	if cr.UniversalAdID != nil {
+		id := *cr.UniversalAdID
+		c.UniversalAdID = &id
+	}
+	c.Linear = cloneLinear(cr.Linear)
+	if cr.CompanionAds != nil {
+		ca := *cr.CompanionAds
+		if ca.Companions != nil {
+			ca.Companions = make([]Companion, len(cr.CompanionAds.Companions))
+			for i := range cr.CompanionAds.Companions {
+				ca.Companions[i] = cloneCompanion(&cr.CompanionAds.Companions[i])
+			}
+		}
+		c.CompanionAds = &ca
+	}
+	if cr.NonLinearAds != nil {
+		nla := *cr.NonLinearAds
+		nla.TrackingEvents = cloneTrackings(nla.TrackingEvents)
+		if nla.NonLinears != nil {
+			nla.NonLinears = make([]NonLinear, len(cr.NonLinearAds.NonLinears))
+			for i := range cr.NonLinearAds.NonLinears {
+				nla.NonLinears[i] = cloneNonLinear(&cr.NonLinearAds.NonLinears[i])
+			}
+		}
+		c.NonLinearAds = &nla
+	}
+	c.CreativeExtensions = cloneExtensionList(cr.CreativeExtensions)
+	return c
+}
+
+// Clone returns a deep copy of the wrapper creative.
+func (cr *CreativeWrapper) Clone() *CreativeWrapper {
+	if cr == nil {
+		return nil
+	}
+	c := cr.clone()
+	return &c
+}
+
+func (cr *CreativeWrapper) clone() CreativeWrapper {
+	c := *cr
+	if cr.Linear != nil {
+		l := *cr.Linear
+		l.Icons = cloneIcons(l.Icons)
+		l.TrackingEvents = cloneTrackings(l.TrackingEvents)
+		l.VideoClicks = cloneVideoClicks(l.VideoClicks)
+		c.Linear = &l
+	}
+	if cr.CompanionAds != nil {
+		ca := *cr.CompanionAds
+		if ca.Companions != nil {
+			ca.Companions = make([]CompanionWrapper, len(cr.CompanionAds.Companions))
+			for i := range cr.CompanionAds.Companions {
+				ca.Companions[i] = cloneCompanionWrapper(&cr.CompanionAds.Companions[i])
+			}
+		}
+		c.CompanionAds = &ca
+	}
+	if cr.NonLinearAds != nil {
+		nla := *cr.NonLinearAds
+		nla.TrackingEvents = cloneTrackings(nla.TrackingEvents)
+		if nla.NonLinears != nil {
+			nla.NonLinears = make([]NonLinearWrapper, len(cr.NonLinearAds.NonLinears))
+			for i, nl := range cr.NonLinearAds.NonLinears {
+				nl.MinSuggestedDuration = cloneDuration(nl.MinSuggestedDuration)
+				nl.TrackingEvents = cloneTrackings(nl.TrackingEvents)
+				nl.NonLinearClickTracking = cloneCDATAs(nl.NonLinearClickTracking)
+				nla.NonLinears[i] = nl
+			}
+		}
+		c.NonLinearAds = &nla
+	}
+	return c
+}
+
+func cloneLinear(l *Linear) *Linear {
+	if l == nil {
+		return nil
+	}
+	c := *l
+	c.SkipOffset = cloneOffset(l.SkipOffset)
+	c.Icons = cloneIcons(l.Icons)
+	c.TrackingEvents = cloneTrackings(l.TrackingEvents)
+	c.AdParameters = cloneAdParameters(l.AdParameters)
+	if l.MediaFiles != nil {
+		c.MediaFiles = append([]MediaFile{}, l.MediaFiles...)
+	}
+	c.VideoClicks = cloneVideoClicks(l.VideoClicks)
+	return &c
+}
+
+func cloneCompanion(comp *Companion) Companion {
+	c := *comp
+	c.HTMLResource = cloneHTMLResource(comp.HTMLResource)
+	c.IFrameResource = cloneCDATA(comp.IFrameResource)
+	c.StaticResource = cloneStaticResource(comp.StaticResource)
+	c.AdParameters = cloneAdParameters(comp.AdParameters)
+	c.CompanionClickThrough = cloneCDATA(comp.CompanionClickThrough)
+	if comp.CompanionClickTrackings != nil {
+		c.CompanionClickTrackings = append([]CompanionClickTracking{}, comp.CompanionClickTrackings...)
+	}
+	c.TrackingEvents = cloneTrackings(comp.TrackingEvents)
+	c.CreativeExtensions = cloneExtensionList(comp.CreativeExtensions)
+	return c
+}
+
+func cloneCompanionWrapper(comp *CompanionWrapper) CompanionWrapper {
+	c := *comp
+	c.CompanionClickThrough = cloneCDATA(comp.CompanionClickThrough)
+	if comp.CompanionClickTracking != nil {
+		c.CompanionClickTracking = append([]CompanionClickTracking{}, comp.CompanionClickTracking...)
+	}
+	c.TrackingEvents = cloneTrackings(comp.TrackingEvents)
+	c.AdParameters = cloneAdParameters(comp.AdParameters)
+	c.StaticResource = cloneStaticResource(comp.StaticResource)
+	c.IFrameResource = cloneCDATA(comp.IFrameResource)
+	c.HTMLResource = cloneHTMLResource(comp.HTMLResource)
+	c.CreativeExtensions = cloneExtensionList(comp.CreativeExtensions)
+	return c
+}
+
+func cloneNonLinear(nl *NonLinear) NonLinear {
+	c := *nl
+	c.MinSuggestedDuration = cloneDuration(nl.MinSuggestedDuration)
+	c.HTMLResource = cloneHTMLResource(nl.HTMLResource)
+	c.IFrameResource = cloneCDATA(nl.IFrameResource)
+	c.StaticResource = cloneStaticResource(nl.StaticResource)
+	c.AdParameters = cloneAdParameters(nl.AdParameters)
+	c.NonLinearClickThrough = cloneCDATA(nl.NonLinearClickThrough)
+	if nl.NonLinearClickTrackings != nil {
+		c.NonLinearClickTrackings = append([]NonLinearClickTracking{}, nl.NonLinearClickTrackings...)
+	}
+	return c
+}
+
+func cloneIcons(icons *Icons) *Icons {
+	if icons == nil {
+		return nil
+	}
+	c := *icons
+	if icons.Icon != nil {
+		c.Icon = make([]Icon, len(icons.Icon))
+		for i, icon := range icons.Icon {
+			icon.Offset = cloneOffset(icon.Offset)
+			icon.Duration = cloneDuration(icon.Duration)
+			icon.HTMLResource = cloneHTMLResource(icon.HTMLResource)
+			icon.IFrameResource = cloneCDATA(icon.IFrameResource)
+			icon.StaticResource = cloneStaticResource(icon.StaticResource)
+			icon.IconClicks = cloneIconClicks(icon.IconClicks)
+			icon.IconViewTrackings = cloneCDATAs(icon.IconViewTrackings)
+			c.Icon[i] = icon
+		}
+	}
+	return &c
+}
+
+func cloneIconClicks(ic *IconClicks) *IconClicks {
+	if ic == nil {
+		return nil
+	}
+	c := *ic
+	c.IconClickThrough = cloneCDATA(ic.IconClickThrough)
+	if ic.IconClickTrackings != nil {
+		c.IconClickTrackings = append([]IconClickTracking{}, ic.IconClickTrackings...)
+	}
+	if ic.IconClickFallbackImages != nil {
+		imgs := *ic.IconClickFallbackImages
+		if imgs.IconClickFallbackImage != nil {
+			imgs.IconClickFallbackImage = make([]IconClickFallbackImage, len(ic.IconClickFallbackImages.IconClickFallbackImage))
+			for i, img := range ic.IconClickFallbackImages.IconClickFallbackImage {
+				img.StaticResource = cloneStaticResource(img.StaticResource)
+				imgs.IconClickFallbackImage[i] = img
+			}
+		}
+		c.IconClickFallbackImages = &imgs
+	}
+	return &c
+}
+
+func cloneVideoClicks(vc *VideoClicks) *VideoClicks {
+	if vc == nil {
+		return nil
+	}
+	c := *vc
+	if vc.ClickTrackings != nil {
+		c.ClickTrackings = append([]VideoClick{}, vc.ClickTrackings...)
+	}
+	if vc.CustomClicks != nil {
+		c.CustomClicks = append([]VideoClick{}, vc.CustomClicks...)
+	}
+	if vc.ClickThroughs != nil {
+		c.ClickThroughs = append([]VideoClick{}, vc.ClickThroughs...)
+	}
+	return &c
+}
+
+func cloneTrackings(ts TrackingEvents) TrackingEvents {
+	if ts == nil {
+		return nil
+	}
+	c := make(TrackingEvents, len(ts))
+	for i, t := range ts {
+		t.Offset = cloneOffset(t.Offset)
+		c[i] = t
+	}
+	return c
+}
+
+func cloneExtensionList(exts *[]Extension) *[]Extension {
+	if exts == nil {
+		return nil
+	}
+	c := cloneExtensions(*exts)
+	return &c
+}
+
+func cloneExtensions(exts []Extension) []Extension {
+	if exts == nil {
+		return nil
+	}
+	c := make([]Extension, len(exts))
+	for i, e := range exts {
+		e.CustomTracking = cloneTrackings(e.CustomTracking)
+		c[i] = e
+	}
+	return c
+}
+
+func cloneImpressions(imps []Impression) []Impression {
+	if imps == nil {
+		return nil
+	}
+	return append([]Impression{}, imps...)
+}
+
+func cloneCDATAs(cs []CDATAString) []CDATAString {
+	if cs == nil {
+		return nil
+	}
+	return append([]CDATAString{}, cs...)
+}
+
+func cloneCDATA(c *CDATAString) *CDATAString {
+	if c == nil {
+		return nil
+	}
+	s := *c
+	return &s
+}
+
+func cloneAdSystem(s *AdSystem) *AdSystem {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	return &c
+}
+
+func clonePricing(p *Pricing) *Pricing {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+func cloneHTMLResource(r *HTMLResource) *HTMLResource {
+	if r == nil {
+		return nil
+	}
+	c := *r
+	return &c
+}
+
+func cloneStaticResource(r *StaticResource) *StaticResource {
+	if r == nil {
+		return nil
+	}
+	c := *r
+	return &c
+}
+
+func cloneAdParameters(p *AdParameters) *AdParameters {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+func cloneOffset(o *Offset) *Offset {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	c.Duration = cloneDuration(o.Duration)
+	return &c
+}
+
+func cloneDuration(d *Duration) *Duration {
+	if d == nil {
+		return nil
+	}
+	c := *d
+	return &c
+}
+
+func cloneBool(b *bool) *bool {
+	if b == nil {
+		return nil
+	}
+	c := *b
+	return &c
+}
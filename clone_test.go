@@ -0,0 +1,130 @@
+package vast
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// references collects the addresses of the pointers and slices reachable
+// from v.
+func references(v reflect.Value, refs map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			refs[v.Pointer()] = true
+			references(v.Elem(), refs)
+		}
+	case reflect.Slice:
+		if v.Cap() > 0 {
+			refs[v.Pointer()] = true
+		}
+		for i := 0; i < v.Len(); i++ {
+			references(v.Index(i), refs)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			references(v.Field(i), refs)
+		}
+	}
+}
+
+func TestCloneFixtures(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".xml"), func(t *testing.T) {
+			b, err := ioutil.ReadFile(file)
+			if !assert.NoError(t, err) {
+				return
+			}
+			var v VAST
+			if err := Unmarshal(b, &v); err != nil {
+				t.Skipf("invalid document: %v", err)
+			}
+			c := v.Clone()
+			assert.Equal(t, &v, c)
+			assert.True(t, v.Equal(c))
+
+			orig, cloned := map[uintptr]bool{}, map[uintptr]bool{}
+			references(reflect.ValueOf(&v), orig)
+			references(reflect.ValueOf(c), cloned)
+			for p := range cloned {
+				assert.False(t, orig[p], "shared reference")
+			}
+		})
+	}
+}
+
+func TestCloneIndependent(t *testing.T) {
+	yes := true
+	d := Duration(5 * time.Second)
+	template := &VAST{
+		Version: "4.2",
+		Ads: []Ad{{
+			InLine: &InLine{
+				AdSystem:    &AdSystem{Name: "DSP"},
+				Extensions:  &[]Extension{{Type: "t", CustomTracking: []Tracking{{Event: Event_type_start, URI: "http://example.com/ext"}}}},
+				Impressions: []Impression{{URI: "http://example.com/imp"}},
+				Creatives: []Creative{{
+					Linear: &Linear{
+						SkipOffset:     &Offset{Duration: &d},
+						TrackingEvents: TrackingEvents{{Event: Event_type_progress, Offset: &Offset{Duration: &d}, URI: "http://example.com/progress"}},
+						MediaFiles:     []MediaFile{{URI: "http://example.com/media.mp4"}},
+					},
+				}},
+			},
+		}, {
+			Wrapper: &Wrapper{FallbackOnNoAd: &yes, VASTAdTagURI: CDATAString{"http://example.com/tag"}},
+		}},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := template.Clone()
+			inline := c.Ads[0].InLine
+			inline.AdSystem.Name = "other"
+			(*inline.Extensions)[0].CustomTracking[0].URI = "http://example.com/other"
+			inline.Impressions[0].URI = "http://example.com/other"
+			*inline.Creatives[0].Linear.SkipOffset.Duration = 0
+			*inline.Creatives[0].Linear.TrackingEvents[0].Offset.Duration = 0
+			inline.Creatives[0].Linear.MediaFiles[0].URI = "http://example.com/other.mp4"
+			*c.Ads[1].Wrapper.FallbackOnNoAd = false
+		}()
+	}
+	wg.Wait()
+
+	inline := template.Ads[0].InLine
+	assert.Equal(t, "DSP", inline.AdSystem.Name)
+	assert.Equal(t, "http://example.com/ext", (*inline.Extensions)[0].CustomTracking[0].URI)
+	assert.Equal(t, "http://example.com/imp", inline.Impressions[0].URI)
+	assert.Equal(t, d, *inline.Creatives[0].Linear.SkipOffset.Duration)
+	assert.Equal(t, d, *inline.Creatives[0].Linear.TrackingEvents[0].Offset.Duration)
+	assert.Equal(t, "http://example.com/media.mp4", inline.Creatives[0].Linear.MediaFiles[0].URI)
+	assert.True(t, *template.Ads[1].Wrapper.FallbackOnNoAd)
+}
+
+func TestCloneNil(t *testing.T) {
+	assert.Nil(t, (*VAST)(nil).Clone())
+	assert.Nil(t, (*Ad)(nil).Clone())
+	assert.Nil(t, (*InLine)(nil).Clone())
+	assert.Nil(t, (*Wrapper)(nil).Clone())
+	assert.Nil(t, (*Creative)(nil).Clone())
+	assert.Nil(t, (*CreativeWrapper)(nil).Clone())
+
+	// empty slices stay empty
+	c := (&VAST{Ads: []Ad{}}).Clone()
+	assert.NotNil(t, c.Ads)
+	assert.Len(t, c.Ads, 0)
+}
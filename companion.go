@@ -0,0 +1,195 @@
+package vast
+
+import "strings"
+
+// CompanionsRequired tells which companions of a CompanionAds the player
+// must display.
+type CompanionsRequired string
+
+const (
+	// The player must display all the companions, or none of the ad.
+	CompanionsRequiredAll CompanionsRequired = "all"
+	// The player must display at least one of the companions.
+	CompanionsRequiredAny CompanionsRequired = "any"
+	// All the companions are optional.
+	CompanionsRequiredNone CompanionsRequired = "none"
+)
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Values are
+// case insensitive, unknown ones are kept as is and treated as "none".
+func (r *CompanionsRequired) UnmarshalText(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	switch v := CompanionsRequired(strings.ToLower(s)); v {
+	case CompanionsRequiredAll, CompanionsRequiredAny, CompanionsRequiredNone:
+		*r = v
+	default:
+		*r = CompanionsRequired(s)
+	}
+	return nil
+}
+
+// RenderingMode tells when a companion should be rendered.
+type RenderingMode string
+
+const (
+	// The companion is rendered along with the ad.
+	RenderingModeDefault RenderingMode = "default"
+	// The companion is rendered once the ad is over.
+	RenderingModeEndCard RenderingMode = "end-card"
+	// The companion is rendered along with the ad, in place of the content.
+	RenderingModeConcurrent RenderingMode = "concurrent"
+)
+
+// ResourceType is the type of resource used to display a companion.
+type ResourceType string
+
+const (
+	// A StaticResource, such as an image
+	ResourceTypeStatic ResourceType = "static"
+	// An IFrameResource
+	ResourceTypeIFrame ResourceType = "iframe"
+	// An HTMLResource
+	ResourceTypeHTML ResourceType = "html"
+)
+
+// CompanionSlot is a publisher placement area in which a companion can be
+// displayed.
+type CompanionSlot struct {
+	// Matched against the adSlotId of the companions. When empty, the
+	// companions are matched on their dimensions only.
+	ID string
+	// Pixel dimensions of the slot.
+	Width int
+	// Pixel dimensions of the slot.
+	Height int
+	// The resource types the slot can display, by order of preference.
+	Resources []ResourceType
+}
+
+// CompanionAssignment is a companion selected to be displayed in a slot.
+type CompanionAssignment struct {
+	Slot      *CompanionSlot
+	Companion *Companion
+	// The resource of the companion to display in the slot
+	Resource ResourceType
+}
+
+// resource returns the first resource type of the slot the companion provides.
+func (s *CompanionSlot) resource(c *Companion) (ResourceType, bool) {
+	for _, r := range s.Resources {
+		switch {
+		case r == ResourceTypeStatic && c.StaticResource != nil,
+			r == ResourceTypeIFrame && c.IFrameResource != nil,
+			r == ResourceTypeHTML && c.HTMLResource != nil:
+			return r, true
+		}
+	}
+	return "", false
+}
+
+// fits reports whether the companion can be displayed in the slot.
+func (s *CompanionSlot) fits(c *Companion) bool {
+	if s.ID != "" && c.AdSlotID != "" {
+		if s.ID != c.AdSlotID {
+			return false
+		}
+	} else if c.Width != s.Width || c.Height != s.Height {
+		return false
+	}
+	_, ok := s.resource(c)
+	return ok
+}
+
+// Select assigns the companions to the given slots, each slot displaying at
+// most one companion and each companion being displayed at most once. As many
+// companions as possible are assigned.
+//
+// When the Required attribute cannot be honored, that is not all companions
+// can be displayed with "all", or none of them with "any", no assignment is
+// returned along with ErrorCodeCompanionRequired: the whole ad must be
+// rejected.
+func (ca *CompanionAds) Select(slots []CompanionSlot) ([]CompanionAssignment, error) {
+	if ca == nil || len(ca.Companions) == 0 {
+		return nil, nil
+	}
+	// bipartite matching of the companions to the slots, using augmenting
+	// paths so that "all" is satisfied whenever a solution exists.
+	slotOf := make([]int, len(ca.Companions))
+	companionOf := make([]int, len(slots))
+	for i := range slotOf {
+		slotOf[i] = -1
+	}
+	for i := range companionOf {
+		companionOf[i] = -1
+	}
+	var augment func(c int, seen []bool) bool
+	augment = func(c int, seen []bool) bool {
+		for s := range slots {
+			if seen[s] || !slots[s].fits(&ca.Companions[c]) {
+				continue
+			}
+			seen[s] = true
+			if companionOf[s] < 0 || augment(companionOf[s], seen) {
+				companionOf[s] = c
+				slotOf[c] = s
+				return true
+			}
+		}
+		return false
+	}
+	n := 0
+	for c := range ca.Companions {
+		if augment(c, make([]bool, len(slots))) {
+			n++
+		}
+	}
+
+	switch ca.Required {
+	case CompanionsRequiredAll:
+		if n < len(ca.Companions) {
+			return nil, ErrorCodeCompanionRequired
+		}
+	case CompanionsRequiredAny:
+		if n == 0 {
+			return nil, ErrorCodeCompanionRequired
+		}
+	}
+
+	var assignments []CompanionAssignment
+	for c, s := range slotOf {
+		if s < 0 {
+			continue
+		}
+		r, _ := slots[s].resource(&ca.Companions[c])
+		assignments = append(assignments, CompanionAssignment{
+			Slot:      &slots[s],
+			Companion: &ca.Companions[c],
+			Resource:  r,
+		})
+	}
+	return assignments, nil
+}
+
+// EndCards returns the companions to render once the ad is over.
+func (ca *CompanionAds) EndCards() []*Companion {
+	if ca == nil {
+		return nil
+	}
+	var cards []*Companion
+	for i := range ca.Companions {
+		if strings.EqualFold(string(ca.Companions[i].RenderingMode), string(RenderingModeEndCard)) {
+			cards = append(cards, &ca.Companions[i])
+		}
+	}
+	return cards
+}
+
+// EndCards returns the companions of all the creatives of the ad to render
+// once the ad is over.
+func (inline *InLine) EndCards() []*Companion {
+	var cards []*Companion
+	for _, c := range inline.Creatives {
+		cards = append(cards, c.CompanionAds.EndCards()...)
+	}
+	return cards
+}
@@ -0,0 +1,139 @@
+package vast
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompanionsRequiredUnmarshal(t *testing.T) {
+	var ca CompanionAds
+	if assert.NoError(t, xml.Unmarshal([]byte(`<CompanionAds required="Any"></CompanionAds>`), &ca)) {
+		assert.Equal(t, CompanionsRequiredAny, ca.Required)
+	}
+	ca = CompanionAds{}
+	if assert.NoError(t, xml.Unmarshal([]byte(`<CompanionAds></CompanionAds>`), &ca)) {
+		assert.Equal(t, CompanionsRequired(""), ca.Required)
+	}
+}
+
+func TestCompanionAdsSelect(t *testing.T) {
+	static := &StaticResource{CreativeType: "image/png", URI: "http://example.com/banner.png"}
+	iframe := &CDATAString{CDATA: "http://example.com/banner.html"}
+	ca := &CompanionAds{
+		Required: CompanionsRequiredAll,
+		Companions: []Companion{
+			{ID: "1", Width: 300, Height: 250, StaticResource: static, IFrameResource: iframe},
+			{ID: "2", Width: 300, Height: 250, StaticResource: static},
+			{ID: "3", AdSlotID: "sidebar", Width: 160, Height: 600, HTMLResource: &HTMLResource{HTML: "<p>ad</p>"}},
+		},
+	}
+	slots := []CompanionSlot{
+		{Width: 300, Height: 250, Resources: []ResourceType{ResourceTypeStatic}},
+		{Width: 300, Height: 250, Resources: []ResourceType{ResourceTypeIFrame, ResourceTypeStatic}},
+		{ID: "sidebar", Resources: []ResourceType{ResourceTypeHTML}},
+	}
+
+	assignments, err := ca.Select(slots)
+	if assert.NoError(t, err) && assert.Len(t, assignments, 3) {
+		// the first companion is moved to the iframe slot so that the second
+		// one can be displayed
+		assert.Equal(t, "1", assignments[0].Companion.ID)
+		assert.Equal(t, &slots[1], assignments[0].Slot)
+		assert.Equal(t, ResourceTypeIFrame, assignments[0].Resource)
+		assert.Equal(t, "2", assignments[1].Companion.ID)
+		assert.Equal(t, &slots[0], assignments[1].Slot)
+		assert.Equal(t, ResourceTypeStatic, assignments[1].Resource)
+		assert.Equal(t, "3", assignments[2].Companion.ID)
+		assert.Equal(t, &slots[2], assignments[2].Slot)
+		assert.Equal(t, ResourceTypeHTML, assignments[2].Resource)
+	}
+
+	// one of the companions cannot be displayed
+	assignments, err = ca.Select(slots[:2])
+	assert.Equal(t, ErrorCodeCompanionRequired, err)
+	assert.EqualError(t, err, "vast error 602")
+	assert.Nil(t, assignments)
+
+	ca.Required = CompanionsRequiredAny
+	assignments, err = ca.Select(slots[2:])
+	if assert.NoError(t, err) && assert.Len(t, assignments, 1) {
+		assert.Equal(t, "3", assignments[0].Companion.ID)
+	}
+	_, err = ca.Select([]CompanionSlot{{Width: 728, Height: 90, Resources: []ResourceType{ResourceTypeStatic}}})
+	assert.Equal(t, ErrorCodeCompanionRequired, err)
+
+	ca.Required = CompanionsRequiredNone
+	assignments, err = ca.Select(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, assignments)
+}
+
+func TestCompanionAdsSelectFixture(t *testing.T) {
+	v, _, _, err := loadFixture("testdata/vast_inline_linear.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	ca := v.Ads[0].InLine.Creatives[1].CompanionAds
+	if !assert.NotNil(t, ca) {
+		return
+	}
+	assert.Equal(t, CompanionsRequiredAll, ca.Required)
+	_, err = ca.Select([]CompanionSlot{{Width: 300, Height: 250, Resources: []ResourceType{ResourceTypeStatic}}})
+	assert.Equal(t, ErrorCodeCompanionRequired, err)
+
+	assignments, err := ca.Select([]CompanionSlot{
+		{Width: 728, Height: 90, Resources: []ResourceType{ResourceTypeStatic}},
+		{Width: 300, Height: 250, Resources: []ResourceType{ResourceTypeStatic}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, assignments, 2)
+}
+
+func TestCompanionVAST41Attributes(t *testing.T) {
+	data := []byte(`<Companion id="1" width="300" height="250" pxratio="2" renderingMode="end-card">` +
+		`<StaticResource creativeType="image/png"><![CDATA[http://example.com/endcard.png]]></StaticResource>` +
+		`<TrackingEvents><Tracking event="creativeView"><![CDATA[http://example.com/view]]></Tracking></TrackingEvents>` +
+		`<CreativeExtensions><CreativeExtension type="application/javascript"><![CDATA[http://example.com/ext.js]]></CreativeExtension></CreativeExtensions>` +
+		`</Companion>`)
+	var c Companion
+	if !assert.NoError(t, xml.Unmarshal(data, &c)) {
+		return
+	}
+	assert.Equal(t, 2.0, c.PxRatio)
+	assert.Equal(t, RenderingModeEndCard, c.RenderingMode)
+	if assert.NotNil(t, c.CreativeExtensions) && assert.Len(t, *c.CreativeExtensions, 1) {
+		assert.Equal(t, "application/javascript", (*c.CreativeExtensions)[0].Type)
+	}
+	b, err := xml.Marshal(c)
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(data), string(b))
+	}
+}
+
+func TestCompanionWrapperOptionalAttributes(t *testing.T) {
+	b, err := xml.Marshal(CompanionWrapper{
+		CompanionClickTracking: []CompanionClickTracking{{ID: "1", URI: "http://example.com/click"}},
+		TrackingEvents:         []Tracking{{Event: "creativeView", URI: "http://example.com/view"}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `<CompanionWrapper><CompanionClickTracking id="1"><![CDATA[http://example.com/click]]></CompanionClickTracking><TrackingEvents><Tracking event="creativeView"><![CDATA[http://example.com/view]]></Tracking></TrackingEvents></CompanionWrapper>`, string(b))
+	}
+}
+
+func TestInLineEndCards(t *testing.T) {
+	inline := &InLine{Creatives: []Creative{
+		{Linear: &Linear{}},
+		{CompanionAds: &CompanionAds{Companions: []Companion{
+			{ID: "banner"},
+			{ID: "card", RenderingMode: RenderingModeEndCard},
+			{ID: "concurrent", RenderingMode: RenderingModeConcurrent},
+		}}},
+	}}
+	cards := inline.EndCards()
+	if assert.Len(t, cards, 1) {
+		assert.Equal(t, "card", cards[0].ID)
+		assert.True(t, &inline.Creatives[1].CompanionAds.Companions[1] == cards[0])
+	}
+}
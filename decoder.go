@@ -0,0 +1,231 @@
+package vast
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	// ErrMaxSizeExceeded is returned by an AdDecoder reading a document
+	// larger than its MaxSize.
+	ErrMaxSizeExceeded = errors.New("vast: maximum document size exceeded")
+	// ErrMaxDepthExceeded is returned by an AdDecoder reading a document
+	// whose elements are nested deeper than its MaxDepth.
+	ErrMaxDepthExceeded = errors.New("vast: maximum element depth exceeded")
+)
+
+// AdDecoder reads the <Ad> elements of a VAST document one at a time, so that
+// only a single ad is held in memory while decoding large responses.
+//
+// The attributes of the root <VAST> element are available through Root and
+// the top-level <Error> elements through Errors. As they may follow the ads,
+// the errors are only complete once Next returned io.EOF. Callers can stop
+// reading at any time, e.g. once a playable ad was found.
+type AdDecoder struct {
+	// The maximum nesting of elements, the <VAST> element being at depth 1.
+	// Zero means no limit.
+	MaxDepth int
+	// The maximum number of bytes read from the input. Zero means no limit.
+	MaxSize int64
+
+	r      *recorder
+	dec    *xml.Decoder
+	root   *VAST
+	errors []CDATAString
+	err    error
+}
+
+// NewAdDecoder returns an AdDecoder reading from r. As with Decode, the
+// document is converted to UTF-8 when encoded otherwise.
+func NewAdDecoder(r io.Reader) *AdDecoder {
+	ur, err := newUTF8Reader(r)
+	if err != nil {
+		ur = &errReader{err}
+	}
+	rec := &recorder{r: bufio.NewReader(ur)}
+	dec := xml.NewDecoder(rec)
+	dec.CharsetReader = utf8CharsetReader
+	return &AdDecoder{r: rec, dec: dec}
+}
+
+// Root returns the root <VAST> element, without its ads and errors.
+func (d *AdDecoder) Root() (*VAST, error) {
+	if d.root != nil {
+		return d.root, nil
+	}
+	if err := d.init(); err != nil {
+		return nil, err
+	}
+	return d.root, nil
+}
+
+// Errors returns the top-level <Error> elements read so far.
+func (d *AdDecoder) Errors() []CDATAString {
+	return d.errors
+}
+
+// Next returns the next ad of the document, or io.EOF once all the ads were
+// read.
+func (d *AdDecoder) Next() (*Ad, error) {
+	if err := d.init(); err != nil {
+		return nil, err
+	}
+	for {
+		d.r.discard(d.dec.InputOffset())
+		start := d.dec.InputOffset()
+		tok, err := d.dec.Token()
+		if err != nil {
+			return nil, d.fail(err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if err := d.skip(2); err != nil {
+				return nil, d.fail(err)
+			}
+			raw := d.r.bytes(start, d.dec.InputOffset())
+			switch t.Name.Local {
+			case "Ad":
+				var ad Ad
+				if err := xml.Unmarshal(raw, &ad); err != nil {
+					return nil, d.fail(err)
+				}
+				return &ad, nil
+			case "Error":
+				var e CDATAString
+				if err := xml.Unmarshal(raw, &e); err != nil {
+					return nil, d.fail(err)
+				}
+				d.errors = append(d.errors, e)
+			}
+		case xml.EndElement:
+			// end of the <VAST> element
+			d.err = io.EOF
+			return nil, io.EOF
+		}
+	}
+}
+
+// init reads the document up to the root <VAST> element.
+func (d *AdDecoder) init() error {
+	if d.err != nil {
+		return d.err
+	}
+	if d.root != nil {
+		return nil
+	}
+	d.r.max = d.MaxSize
+	for {
+		d.r.discard(d.dec.InputOffset())
+		start := d.dec.InputOffset()
+		tok, err := d.dec.Token()
+		if err != nil {
+			return d.fail(err)
+		}
+		t, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if t.Name.Local != "VAST" {
+			return d.fail(fmt.Errorf("vast: unexpected root element <%s>", t.Name.Local))
+		}
+		raw := d.r.bytes(start, d.dec.InputOffset())
+		if !bytes.HasSuffix(raw, []byte("/>")) {
+			raw = append(raw[:len(raw):len(raw)], "</VAST>"...)
+		}
+		var v VAST
+		if err := xml.Unmarshal(raw, &v); err != nil {
+			return d.fail(err)
+		}
+		d.root = &v
+		return nil
+	}
+}
+
+// skip reads the tokens up to the end of the current element, found at the
+// given depth.
+func (d *AdDecoder) skip(depth int) error {
+	for n := depth; ; {
+		if d.MaxDepth > 0 && n > d.MaxDepth {
+			return ErrMaxDepthExceeded
+		}
+		tok, err := d.dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			n++
+		case xml.EndElement:
+			if n == depth {
+				return nil
+			}
+			n--
+		}
+	}
+}
+
+// fail records a decoding error, which is then returned by all the
+// subsequent calls.
+func (d *AdDecoder) fail(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	d.err = err
+	return err
+}
+
+// recorder is a reader keeping the bytes read since the last discard, so
+// that the raw XML of the elements found by the decoder can be retrieved.
+// It implements io.ByteReader so that the xml.Decoder does not buffer its
+// input and reports exact offsets.
+type recorder struct {
+	r *bufio.Reader
+	// the bytes read starting from offset base
+	buf  []byte
+	base int64
+	n    int64
+	max  int64
+}
+
+// ReadByte implements the io.ByteReader interface.
+func (r *recorder) ReadByte() (byte, error) {
+	if r.max > 0 && r.n >= r.max {
+		return 0, ErrMaxSizeExceeded
+	}
+	b, err := r.r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	r.buf = append(r.buf, b)
+	r.n++
+	return b, nil
+}
+
+// Read implements the io.Reader interface.
+func (r *recorder) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	p[0] = b
+	return 1, nil
+}
+
+// discard drops the bytes before the given offset.
+func (r *recorder) discard(offset int64) {
+	n := copy(r.buf, r.buf[offset-r.base:])
+	r.buf = r.buf[:n]
+	r.base = offset
+}
+
+// bytes returns the bytes between the given offsets.
+func (r *recorder) bytes(from, to int64) []byte {
+	return r.buf[from-r.base : to-r.base]
+}
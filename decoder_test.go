@@ -0,0 +1,148 @@
+package vast
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const multiAdsXML = `<?xml version="1.0" encoding="UTF-8"?>
+<VAST version="4.1" xmlns="http://www.iab.com/VAST">
+	<Ad id="1" sequence="1">
+		<InLine>
+			<AdSystem>DSP</AdSystem>
+			<AdTitle><![CDATA[first]]></AdTitle>
+			<Extensions><Extension type="big"><Data><![CDATA[payload]]></Data></Extension></Extensions>
+		</InLine>
+	</Ad>
+	<Ad id="2" sequence="2">
+		<Wrapper>
+			<VASTAdTagURI><![CDATA[http://example.com/tag]]></VASTAdTagURI>
+		</Wrapper>
+	</Ad>
+	<Error><![CDATA[http://example.com/error?code=[ERRORCODE]]]></Error>
+	<Ad id="3"><InLine><AdTitle>third</AdTitle></InLine></Ad>
+</VAST>`
+
+func TestAdDecoder(t *testing.T) {
+	d := NewAdDecoder(strings.NewReader(multiAdsXML))
+	root, err := d.Root()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "4.1", root.Version)
+		assert.Equal(t, "http://www.iab.com/VAST", root.XMLNS)
+		assert.Empty(t, root.Ads)
+	}
+
+	ad, err := d.Next()
+	if assert.NoError(t, err) && assert.NotNil(t, ad.InLine) {
+		assert.Equal(t, "1", ad.ID)
+		assert.Equal(t, "first", ad.InLine.AdTitle.CDATA)
+		if assert.NotNil(t, ad.InLine.Extensions) && assert.Len(t, *ad.InLine.Extensions, 1) {
+			assert.Equal(t, "<Data><![CDATA[payload]]></Data>", (*ad.InLine.Extensions)[0].Data)
+		}
+	}
+	ad, err = d.Next()
+	if assert.NoError(t, err) && assert.NotNil(t, ad.Wrapper) {
+		assert.Equal(t, "2", ad.ID)
+		assert.Equal(t, "http://example.com/tag", ad.Wrapper.VASTAdTagURI.CDATA)
+	}
+	assert.Empty(t, d.Errors())
+	ad, err = d.Next()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "3", ad.ID)
+	}
+	if assert.Len(t, d.Errors(), 1) {
+		assert.Equal(t, "http://example.com/error?code=[ERRORCODE]", d.Errors()[0].CDATA)
+	}
+	_, err = d.Next()
+	assert.Equal(t, io.EOF, err)
+	_, err = d.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestAdDecoderFixtures(t *testing.T) {
+	for _, path := range []string{
+		"testdata/vast_inline_linear.xml",
+		"testdata/vast_wrapper_linear_1.xml",
+		"testdata/spotx_vpaid.xml",
+		"testdata/inline_extensions.xml",
+	} {
+		v, _, _, err := loadFixture(path)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		f, err := os.Open(path)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		d := NewAdDecoder(f)
+		var ads []Ad
+		for {
+			ad, err := d.Next()
+			if err == io.EOF {
+				break
+			}
+			if !assert.NoError(t, err, path) {
+				break
+			}
+			ads = append(ads, *ad)
+		}
+		f.Close()
+		assert.Equal(t, v.Ads, ads, path)
+	}
+}
+
+func TestAdDecoderEmpty(t *testing.T) {
+	d := NewAdDecoder(strings.NewReader(`<VAST version="3.0"/>`))
+	_, err := d.Next()
+	assert.Equal(t, io.EOF, err)
+	root, err := d.Root()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "3.0", root.Version)
+	}
+
+	d = NewAdDecoder(strings.NewReader(`<VAST version="3.0"><Error>http://example.com/noad</Error></VAST>`))
+	_, err = d.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Len(t, d.Errors(), 1)
+}
+
+func TestAdDecoderLimits(t *testing.T) {
+	d := NewAdDecoder(strings.NewReader(multiAdsXML))
+	d.MaxDepth = 3
+	_, err := d.Next()
+	assert.Equal(t, ErrMaxDepthExceeded, err)
+
+	d = NewAdDecoder(strings.NewReader(multiAdsXML))
+	d.MaxSize = 400
+	_, err = d.Next()
+	assert.NoError(t, err)
+	_, err = d.Next()
+	assert.Equal(t, ErrMaxSizeExceeded, err)
+
+	d = NewAdDecoder(strings.NewReader(`<VAST><Ad id="1"><InLine>`))
+	_, err = d.Next()
+	assert.EqualError(t, err, "XML syntax error on line 1: unexpected EOF")
+
+	d = NewAdDecoder(strings.NewReader(`<VMAP></VMAP>`))
+	_, err = d.Next()
+	assert.EqualError(t, err, "vast: unexpected root element <VMAP>")
+}
+
+func BenchmarkAdDecoder(b *testing.B) {
+	ad := `<Ad id="1"><InLine><AdTitle>title</AdTitle><Extensions><Extension type="x">` +
+		strings.Repeat("<Data>0123456789</Data>", 100) + `</Extension></Extensions></InLine></Ad>`
+	doc := `<VAST version="4.1">` + strings.Repeat(ad, 500) + `</VAST>`
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := NewAdDecoder(strings.NewReader(doc))
+		for {
+			if _, err := d.Next(); err != nil {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package vast
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind tells how an element changed between two documents.
+type ChangeKind string
+
+const (
+	// The element is only present in the new document.
+	ChangeAdded ChangeKind = "added"
+	// The element is only present in the old document.
+	ChangeRemoved ChangeKind = "removed"
+	// The value of the element differs.
+	ChangeModified ChangeKind = "modified"
+)
+
+// Change is a difference between two documents.
+type Change struct {
+	// The path of the element, made of the Go field names and of the keys of
+	// the list items, e.g. Ads[id=1].InLine.Creatives[id=2].Linear.MediaFiles[id=3].Bitrate
+	Path string
+	Kind ChangeKind
+	// The old value, nil for an added element
+	Old interface{}
+	// The new value, nil for a removed element
+	New interface{}
+}
+
+// String implements the fmt.Stringer interface. Values are only rendered for
+// the changes of attributes and text elements, the path identifying the
+// added or removed ads, creatives, trackers...
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeAdded:
+		if s, ok := renderValue(c.New); ok {
+			return "+ " + c.Path + ": " + s
+		}
+		return "+ " + c.Path
+	case ChangeRemoved:
+		if s, ok := renderValue(c.Old); ok {
+			return "- " + c.Path + ": " + s
+		}
+		return "- " + c.Path
+	}
+	o, _ := renderValue(c.Old)
+	n, _ := renderValue(c.New)
+	return "~ " + c.Path + ": " + o + " -> " + n
+}
+
+// Changes is the list of differences between two documents.
+type Changes []Change
+
+// String renders the changes one per line.
+func (cs Changes) String() string {
+	lines := make([]string, len(cs))
+	for i, c := range cs {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Diff compares two documents and returns what changed from a to b.
+//
+// The list items are matched by stable keys rather than by their position,
+// so that inserting an ad or a tracker does not report all the following
+// ones as modified:
+//
+//   - ads by their id
+//   - creatives by their id, or by their adId
+//   - media files by their id, or by their URI
+//   - trackers by their event and URI
+//   - impressions, clicks and error URLs by their URI
+//
+// Items without key are matched by position. The differences ignored by
+// Equal are ignored as well: Diff returns no change when a.Equal(b).
+func Diff(a, b *VAST) Changes {
+	var d differ
+	d.diff("", reflect.ValueOf(a), reflect.ValueOf(b))
+	return d.changes
+}
+
+type differ struct {
+	changes Changes
+}
+
+func (d *differ) add(path string, kind ChangeKind, from, to reflect.Value) {
+	c := Change{Path: path, Kind: kind}
+	if from.IsValid() {
+		c.Old = from.Interface()
+	}
+	if to.IsValid() {
+		c.New = to.Interface()
+	}
+	d.changes = append(d.changes, c)
+}
+
+var (
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	cdataStringType   = reflect.TypeOf(CDATAString{})
+)
+
+// isLeaf reports whether values of type t are compared as a whole.
+func isLeaf(t reflect.Type) bool {
+	if t == cdataStringType || t.Implements(textMarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Ptr:
+		return false
+	}
+	return true
+}
+
+func (d *differ) diff(path string, a, b reflect.Value) {
+	t := a.Type()
+	switch {
+	case t.Kind() == reflect.Ptr:
+		if t.Elem().Kind() == reflect.Slice {
+			// absent and empty lists are the same
+			d.diff(path, derefSlice(a), derefSlice(b))
+			return
+		}
+		switch {
+		case a.IsNil() && b.IsNil():
+		case a.IsNil():
+			d.add(path, ChangeAdded, reflect.Value{}, b.Elem())
+		case b.IsNil():
+			d.add(path, ChangeRemoved, a.Elem(), reflect.Value{})
+		default:
+			d.diff(path, a.Elem(), b.Elem())
+		}
+	case isLeaf(t):
+		if !equalLeaf(a, b) {
+			d.add(path, ChangeModified, a, b)
+		}
+	case t.Kind() == reflect.Slice:
+		d.diffSlice(path, a, b)
+	case t.Kind() == reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			p := f.Name
+			if path != "" {
+				p = path + "." + f.Name
+			}
+			if isText(f) {
+				if strings.TrimSpace(a.Field(i).String()) != strings.TrimSpace(b.Field(i).String()) {
+					d.add(p, ChangeModified, a.Field(i), b.Field(i))
+				}
+				continue
+			}
+			d.diff(p, a.Field(i), b.Field(i))
+		}
+	}
+}
+
+func (d *differ) diffSlice(path string, a, b reflect.Value) {
+	ka, kb := sliceKeys(a), sliceKeys(b)
+	ib := make(map[string]int, len(kb))
+	for i, k := range kb {
+		ib[k] = i
+	}
+	ia := make(map[string]bool, len(ka))
+	for i, k := range ka {
+		ia[k] = true
+		p := path + "[" + k + "]"
+		if j, ok := ib[k]; ok {
+			d.diff(p, a.Index(i), b.Index(j))
+		} else {
+			d.add(p, ChangeRemoved, a.Index(i), reflect.Value{})
+		}
+	}
+	for j, k := range kb {
+		if !ia[k] {
+			d.add(path+"["+k+"]", ChangeAdded, reflect.Value{}, b.Index(j))
+		}
+	}
+}
+
+// sliceKeys returns the keys of the items of a list, numbering the
+// duplicates.
+func sliceKeys(v reflect.Value) []string {
+	keys := make([]string, v.Len())
+	seen := make(map[string]int, v.Len())
+	for i := range keys {
+		k := itemKey(v.Index(i).Interface(), i)
+		if n := seen[k]; n > 0 {
+			seen[k] = n + 1
+			k += "#" + strconv.Itoa(n+1)
+		} else {
+			seen[k] = 1
+		}
+		keys[i] = k
+	}
+	return keys
+}
+
+// itemKey returns the stable key of a list item, or its position.
+func itemKey(item interface{}, i int) string {
+	switch e := item.(type) {
+	case Ad:
+		if e.ID != "" {
+			return "id=" + e.ID
+		}
+	case Creative:
+		if e.ID != "" {
+			return "id=" + e.ID
+		}
+		if e.AdID != "" {
+			return "adId=" + e.AdID
+		}
+	case CreativeWrapper:
+		if e.ID != "" {
+			return "id=" + e.ID
+		}
+		if e.AdID != "" {
+			return "adId=" + e.AdID
+		}
+	case MediaFile:
+		if e.ID != "" {
+			return "id=" + e.ID
+		}
+		return strings.TrimSpace(e.URI)
+	case Tracking:
+		return string(e.Event) + " " + strings.TrimSpace(e.URI)
+	case Impression:
+		return strings.TrimSpace(e.URI)
+	case VideoClick:
+		return strings.TrimSpace(e.URI)
+	case CompanionClickTracking:
+		return strings.TrimSpace(e.URI)
+	case NonLinearClickTracking:
+		return strings.TrimSpace(e.URI)
+	case IconClickTracking:
+		return strings.TrimSpace(e.URI)
+	case CDATAString:
+		return strings.TrimSpace(e.CDATA)
+	}
+	return strconv.Itoa(i)
+}
+
+func derefSlice(v reflect.Value) reflect.Value {
+	if v.IsNil() {
+		return reflect.Zero(v.Type().Elem())
+	}
+	return v.Elem()
+}
+
+func equalLeaf(a, b reflect.Value) bool {
+	if a.Type() == cdataStringType {
+		return strings.TrimSpace(a.Field(0).String()) == strings.TrimSpace(b.Field(0).String())
+	}
+	return equalValue(a, b)
+}
+
+// renderValue formats the value of an attribute or a text element.
+func renderValue(v interface{}) (string, bool) {
+	switch e := v.(type) {
+	case nil:
+		return "", false
+	case CDATAString:
+		return strconv.Quote(strings.TrimSpace(e.CDATA)), true
+	case string:
+		return strconv.Quote(strings.TrimSpace(e)), true
+	case encoding.TextMarshaler:
+		b, err := e.MarshalText()
+		if err != nil {
+			return fmt.Sprint(v), true
+		}
+		return string(b), true
+	}
+	if !isLeaf(reflect.TypeOf(v)) {
+		return "", false
+	}
+	return fmt.Sprint(v), true
+}
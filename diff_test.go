@@ -0,0 +1,128 @@
+package vast
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	d15 := Duration(15 * time.Second)
+	old := &VAST{
+		Version: "3.0",
+		Ads: []Ad{{
+			ID: "1",
+			InLine: &InLine{
+				AdSystem:    &AdSystem{Name: "DSP"},
+				AdTitle:     CDATAString{"title"},
+				Impressions: []Impression{{URI: "http://example.com/imp"}},
+				Creatives: []Creative{{
+					ID: "c1",
+					Linear: &Linear{
+						Duration: Duration(30 * time.Second),
+						TrackingEvents: TrackingEvents{
+							{Event: Event_type_start, URI: "http://example.com/start"},
+							{Event: Event_type_complete, URI: "http://example.com/complete"},
+						},
+						MediaFiles: []MediaFile{
+							{ID: "m1", Bitrate: 500, URI: "http://example.com/low.mp4"},
+							{ID: "m2", Bitrate: 1000, URI: "http://example.com/high.mp4"},
+						},
+					},
+				}},
+			},
+		}, {
+			ID:      "2",
+			Wrapper: &Wrapper{VASTAdTagURI: CDATAString{"http://example.com/tag"}},
+		}},
+	}
+	updated := old.Clone()
+	updated.Version = "4.0"
+	// ads inserted before the existing ones are matched by id
+	updated.Ads = append([]Ad{{ID: "3", Wrapper: &Wrapper{VASTAdTagURI: CDATAString{"http://example.com/tag3"}}}}, updated.Ads[:1]...)
+	inline := updated.Ads[1].InLine
+	inline.AdTitle.CDATA = " new title "
+	inline.Impressions[0].URI = "\n http://example.com/imp\n"
+	linear := inline.Creatives[0].Linear
+	linear.Duration = Duration(20 * time.Second)
+	linear.SkipOffset = &Offset{Duration: &d15}
+	linear.TrackingEvents = append(TrackingEvents{{Event: Event_type_firstQuartile, URI: "http://example.com/q1"}}, linear.TrackingEvents[1:]...)
+	linear.MediaFiles = linear.MediaFiles[1:]
+	linear.MediaFiles[0].Bitrate = 1200
+
+	changes := Diff(old, updated)
+	assert.Equal(t, Changes{
+		{Path: "Version", Kind: ChangeModified, Old: "3.0", New: "4.0"},
+		{Path: "Ads[id=1].InLine.AdTitle", Kind: ChangeModified, Old: CDATAString{"title"}, New: CDATAString{" new title "}},
+		{Path: "Ads[id=1].InLine.Creatives[id=c1].Linear.SkipOffset", Kind: ChangeAdded, New: Offset{Duration: &d15}},
+		{Path: "Ads[id=1].InLine.Creatives[id=c1].Linear.TrackingEvents[start http://example.com/start]", Kind: ChangeRemoved, Old: old.Ads[0].InLine.Creatives[0].Linear.TrackingEvents[0]},
+		{Path: "Ads[id=1].InLine.Creatives[id=c1].Linear.TrackingEvents[firstQuartile http://example.com/q1]", Kind: ChangeAdded, New: linear.TrackingEvents[0]},
+		{Path: "Ads[id=1].InLine.Creatives[id=c1].Linear.Duration", Kind: ChangeModified, Old: Duration(30 * time.Second), New: Duration(20 * time.Second)},
+		{Path: "Ads[id=1].InLine.Creatives[id=c1].Linear.MediaFiles[id=m1]", Kind: ChangeRemoved, Old: old.Ads[0].InLine.Creatives[0].Linear.MediaFiles[0]},
+		{Path: "Ads[id=1].InLine.Creatives[id=c1].Linear.MediaFiles[id=m2].Bitrate", Kind: ChangeModified, Old: 1000, New: 1200},
+		{Path: "Ads[id=2]", Kind: ChangeRemoved, Old: old.Ads[1]},
+		{Path: "Ads[id=3]", Kind: ChangeAdded, New: updated.Ads[0]},
+	}, changes)
+
+	assert.Equal(t, `~ Version: "3.0" -> "4.0"
+~ Ads[id=1].InLine.AdTitle: "title" -> "new title"
++ Ads[id=1].InLine.Creatives[id=c1].Linear.SkipOffset: 00:00:15
+- Ads[id=1].InLine.Creatives[id=c1].Linear.TrackingEvents[start http://example.com/start]
++ Ads[id=1].InLine.Creatives[id=c1].Linear.TrackingEvents[firstQuartile http://example.com/q1]
+~ Ads[id=1].InLine.Creatives[id=c1].Linear.Duration: 00:00:30 -> 00:00:20
+- Ads[id=1].InLine.Creatives[id=c1].Linear.MediaFiles[id=m1]
+~ Ads[id=1].InLine.Creatives[id=c1].Linear.MediaFiles[id=m2].Bitrate: 1000 -> 1200
+- Ads[id=2]
++ Ads[id=3]`, changes.String())
+}
+
+func TestDiffKeys(t *testing.T) {
+	old := &VAST{Ads: []Ad{{InLine: &InLine{
+		Errors: []CDATAString{{"http://example.com/error"}},
+		Creatives: []Creative{{
+			AdID: "a1",
+			Linear: &Linear{
+				TrackingEvents: TrackingEvents{
+					{Event: Event_type_start, URI: "http://example.com/t"},
+					{Event: Event_type_start, URI: "http://example.com/t"},
+				},
+				MediaFiles: []MediaFile{{URI: "http://example.com/a.mp4", Width: 640}},
+			},
+		}},
+	}}}}
+	updated := old.Clone()
+	updated.Ads[0].InLine.Errors = []CDATAString{{"http://example.com/error2"}}
+	updated.Ads[0].InLine.Extensions = &[]Extension{}
+	linear := updated.Ads[0].InLine.Creatives[0].Linear
+	linear.TrackingEvents = linear.TrackingEvents[:1]
+	linear.MediaFiles[0].Width = 1280
+
+	assert.Equal(t, `- Ads[0].InLine.Errors[http://example.com/error]: "http://example.com/error"
++ Ads[0].InLine.Errors[http://example.com/error2]: "http://example.com/error2"
+- Ads[0].InLine.Creatives[adId=a1].Linear.TrackingEvents[start http://example.com/t#2]
+~ Ads[0].InLine.Creatives[adId=a1].Linear.MediaFiles[http://example.com/a.mp4].Width: 640 -> 1280`, Diff(old, updated).String())
+}
+
+func TestDiffEqual(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".xml"), func(t *testing.T) {
+			b, err := ioutil.ReadFile(file)
+			if !assert.NoError(t, err) {
+				return
+			}
+			var v VAST
+			if err := Unmarshal(b, &v); err != nil {
+				t.Skipf("invalid document: %v", err)
+			}
+			assert.Empty(t, Diff(&v, v.Clone()))
+		})
+	}
+}
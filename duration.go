@@ -34,13 +34,14 @@ func (dur *Duration) UnmarshalText(data []byte) (err error) {
 	if len(parts) != 3 {
 		return fmt.Errorf("invalid duration: %s", data)
 	}
+	var d Duration
 	if i := strings.IndexByte(parts[2], '.'); i > 0 {
 		ms, err := strconv.ParseInt(parts[2][i+1:], 10, 32)
 		if err != nil || ms < 0 || ms > 999 {
 			return fmt.Errorf("invalid duration: %s", data)
 		}
 		parts[2] = parts[2][:i]
-		*dur += Duration(ms) * Duration(time.Millisecond)
+		d += Duration(ms) * Duration(time.Millisecond)
 	}
 	f := Duration(time.Second)
 	for i := 2; i >= 0; i-- {
@@ -48,8 +49,9 @@ func (dur *Duration) UnmarshalText(data []byte) (err error) {
 		if err != nil || n < 0 || n > 59 {
 			return fmt.Errorf("invalid duration: %s", data)
 		}
-		*dur += Duration(n) * f
+		d += Duration(n) * f
 		f *= 60
 	}
+	*dur = d
 	return nil
 }
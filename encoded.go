@@ -0,0 +1,145 @@
+package vast
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// The HTML of an HTMLResource, and the parameters of AdParameters, are either
+// wrapped in a CDATA section or, when xmlEncoded is true, XML-escaped. Some ad
+// servers escape the payload and wrap it in a CDATA section anyway: the
+// payload is then unescaped once when unmarshaling, so that the HTML and
+// Parameters fields always hold the payload itself, whichever way it was
+// written.
+
+// DecodedHTML returns the HTML of the resource, which was unescaped when
+// unmarshaling if needed.
+func (r *HTMLResource) DecodedHTML() string {
+	return r.HTML
+}
+
+// SetHTML sets the HTML of the resource. When xmlEncoded is true, the HTML is
+// XML-escaped when marshaling instead of being wrapped in a CDATA section.
+func (r *HTMLResource) SetHTML(html string, xmlEncoded bool) {
+	r.HTML = html
+	r.XMLEncoded = xmlEncoded
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (r HTMLResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !r.XMLEncoded {
+		type htmlResource HTMLResource
+		return e.EncodeElement(htmlResource(r), start)
+	}
+	return encodePayload(e, start, r.HTML)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (r *HTMLResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var p rawPayload
+	if err := d.DecodeElement(&p, &start); err != nil {
+		return err
+	}
+	r.XMLEncoded = p.XMLEncoded
+	r.HTML = p.decode()
+	return nil
+}
+
+// DecodedParameters returns the parameters, which were unescaped when
+// unmarshaling if needed.
+func (p *AdParameters) DecodedParameters() string {
+	return p.Parameters
+}
+
+// SetParameters sets the parameters. When xmlEncoded is true, the parameters
+// are XML-escaped when marshaling instead of being wrapped in a CDATA
+// section.
+func (p *AdParameters) SetParameters(params string, xmlEncoded bool) {
+	p.Parameters = params
+	p.XMLEncoded = xmlEncoded
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (p AdParameters) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !p.XMLEncoded {
+		type adParameters AdParameters
+		return e.EncodeElement(adParameters(p), start)
+	}
+	return encodePayload(e, start, p.Parameters)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (p *AdParameters) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var raw rawPayload
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+	p.XMLEncoded = raw.XMLEncoded
+	p.Parameters = raw.decode()
+	return nil
+}
+
+// encodePayload writes an xmlEncoded element.
+func encodePayload(e *xml.Encoder, start xml.StartElement, payload string) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlEncoded"}, Value: "true"})
+	return e.EncodeElement(payload, start)
+}
+
+// rawPayload is an HTMLResource or AdParameters element as written.
+type rawPayload struct {
+	XMLEncoded bool   `xml:"xmlEncoded,attr"`
+	Inner      string `xml:",innerxml"`
+}
+
+// decode returns the payload of the element: its text is unescaped by the
+// XML parser, and its CDATA sections are taken as is or, when the element is
+// xmlEncoded, unescaped once.
+func (p *rawPayload) decode() string {
+	doc := "<p>" + p.Inner + "</p>"
+	d := xml.NewDecoder(strings.NewReader(doc))
+	d.Strict = false
+	var b strings.Builder
+	depth := 0
+	for {
+		offset := d.InputOffset()
+		t, err := d.Token()
+		if err != nil {
+			return b.String()
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth != 1 {
+				continue
+			}
+			if p.XMLEncoded && strings.HasPrefix(doc[offset:], "<![CDATA[") {
+				b.WriteString(unescapeXML(string(t)))
+			} else {
+				b.Write(t)
+			}
+		}
+	}
+}
+
+// unescapeXML unescapes the XML entities of s, keeping the unknown entities
+// and the stray ampersands as is.
+func unescapeXML(s string) string {
+	if !strings.Contains(s, "&") {
+		return s
+	}
+	d := xml.NewDecoder(strings.NewReader(strings.Replace(s, "<", "&lt;", -1)))
+	d.Strict = false
+	var b strings.Builder
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return b.String()
+		}
+		if c, ok := t.(xml.CharData); ok {
+			b.Write(c)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package vast
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+func TestHTMLResourceEncoded(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/spotx_html_resource.html")
+	if !assert.NoError(t, err) {
+		return
+	}
+	html := string(b)
+
+	for name, doc := range map[string]string{
+		"cdata":         `<HTMLResource><![CDATA[` + html + `]]></HTMLResource>`,
+		"escaped":       `<HTMLResource xmlEncoded="true">` + escapeXML(html) + `</HTMLResource>`,
+		"escaped cdata": `<HTMLResource xmlEncoded="true"><![CDATA[` + escapeXML(html) + `]]></HTMLResource>`,
+	} {
+		var r HTMLResource
+		if assert.NoError(t, xml.Unmarshal([]byte(doc), &r), name) {
+			assert.Equal(t, html, r.DecodedHTML(), name)
+		}
+	}
+
+	var r HTMLResource
+	r.SetHTML(html, false)
+	out, err := xml.Marshal(r)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `<HTMLResource><![CDATA[`+html+`]]></HTMLResource>`, string(out))
+	}
+
+	r.SetHTML(html, true)
+	out, err = xml.Marshal(r)
+	if assert.NoError(t, err) {
+		assert.True(t, strings.HasPrefix(string(out), `<HTMLResource xmlEncoded="true">&lt;a href=`), string(out))
+		assert.NotContains(t, string(out), "CDATA")
+		var r2 HTMLResource
+		if assert.NoError(t, xml.Unmarshal(out, &r2)) {
+			assert.True(t, r2.XMLEncoded)
+			assert.Equal(t, html, r2.HTML)
+			assert.Equal(t, html, r2.DecodedHTML())
+		}
+	}
+
+	// the payload is decoded exactly once
+	for doc, want := range map[string]string{
+		`<HTMLResource xmlEncoded="true"><![CDATA[&amp;lt;img src=x onerror=alert(1)&amp;gt;]]></HTMLResource>`: `&lt;img src=x onerror=alert(1)&gt;`,
+		`<HTMLResource xmlEncoded="true">&amp;lt;b&amp;gt;</HTMLResource>`:                                      `&lt;b&gt;`,
+		`<HTMLResource><![CDATA[&lt;b&gt;]]></HTMLResource>`:                                                    `&lt;b&gt;`,
+		`<HTMLResource xmlEncoded="true">&lt;b&gt;<![CDATA[&lt;i&gt;]]></HTMLResource>`:                         `<b><i>`,
+	} {
+		var r HTMLResource
+		if !assert.NoError(t, xml.Unmarshal([]byte(doc), &r), doc) {
+			continue
+		}
+		assert.Equal(t, want, r.HTML, doc)
+		out, err := xml.Marshal(r)
+		if assert.NoError(t, err, doc) {
+			var r2 HTMLResource
+			if assert.NoError(t, xml.Unmarshal(out, &r2), doc) {
+				assert.Equal(t, want, r2.HTML, "round trip of "+doc)
+			}
+		}
+	}
+}
+
+func TestAdParametersEncoded(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/spotx_adparameters.txt")
+	if !assert.NoError(t, err) {
+		return
+	}
+	params := string(b)
+
+	for name, doc := range map[string]string{
+		"cdata":         `<AdParameters><![CDATA[` + params + `]]></AdParameters>`,
+		"escaped":       `<AdParameters xmlEncoded="true">` + escapeXML(params) + `</AdParameters>`,
+		"escaped cdata": `<AdParameters xmlEncoded="true"><![CDATA[` + escapeXML(params) + `]]></AdParameters>`,
+	} {
+		var p AdParameters
+		if assert.NoError(t, xml.Unmarshal([]byte(doc), &p), name) {
+			assert.Equal(t, params, p.DecodedParameters(), name)
+		}
+	}
+
+	var p AdParameters
+	p.SetParameters(params, true)
+	out, err := xml.Marshal(p)
+	if assert.NoError(t, err) {
+		assert.True(t, strings.HasPrefix(string(out), `<AdParameters xmlEncoded="true">{&#34;ad_id&#34;:`), string(out))
+		var p2 AdParameters
+		if assert.NoError(t, xml.Unmarshal(out, &p2)) {
+			assert.Equal(t, params, p2.DecodedParameters())
+		}
+	}
+
+	p.SetParameters(params, false)
+	out, err = xml.Marshal(p)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `<AdParameters><![CDATA[`+params+`]]></AdParameters>`, string(out))
+	}
+
+	// the payload is decoded exactly once
+	for doc, want := range map[string]string{
+		`<AdParameters xmlEncoded="true">a=1&amp;amp;b=2</AdParameters>`:         "a=1&amp;b=2",
+		`<AdParameters xmlEncoded="true"><![CDATA[a=1&amp;b=2]]></AdParameters>`: "a=1&b=2",
+		`<AdParameters><![CDATA[a=1&amp;b=2]]></AdParameters>`:                   "a=1&amp;b=2",
+	} {
+		var p AdParameters
+		if assert.NoError(t, xml.Unmarshal([]byte(doc), &p), doc) {
+			assert.Equal(t, want, p.Parameters, doc)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package vast
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Equal reports whether v and o describe the same document. The comparison
+// is structural but ignores the differences that don't change the meaning
+// of a document:
+//
+//   - nil and empty lists are equal, as well as absent and empty
+//     <Extensions> or <CreativeExtensions> elements
+//   - the leading and trailing whitespace of text elements, e.g. the URLs of
+//     trackers, is ignored
+//
+// The raw XML of extensions is compared as is.
+func (v *VAST) Equal(o *VAST) bool {
+	return equalValue(reflect.ValueOf(v), reflect.ValueOf(o))
+}
+
+// Equal reports whether ad and o describe the same ad, see VAST.Equal.
+func (ad *Ad) Equal(o *Ad) bool {
+	return equalValue(reflect.ValueOf(ad), reflect.ValueOf(o))
+}
+
+func equalValue(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() && b.IsNil() {
+				return true
+			}
+			// absent or empty lists
+			if a.Type().Elem().Kind() == reflect.Slice {
+				return (a.IsNil() || a.Elem().Len() == 0) && (b.IsNil() || b.Elem().Len() == 0)
+			}
+			return false
+		}
+		return equalValue(a.Elem(), b.Elem())
+	case reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !equalValue(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		t := a.Type()
+		for i := 0; i < t.NumField(); i++ {
+			fa, fb := a.Field(i), b.Field(i)
+			if isText(t.Field(i)) {
+				if strings.TrimSpace(fa.String()) != strings.TrimSpace(fb.String()) {
+					return false
+				}
+			} else if !equalValue(fa, fb) {
+				return false
+			}
+		}
+		return true
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// isText reports whether the field holds the text content of an element.
+func isText(f reflect.StructField) bool {
+	return f.Type.Kind() == reflect.String && strings.HasSuffix(f.Tag.Get("xml"), ",cdata")
+}
@@ -0,0 +1,85 @@
+package vast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	d := Duration(5 * time.Second)
+	d2 := Duration(5 * time.Second)
+	base := func() *VAST {
+		return &VAST{
+			Version: "3.0",
+			Ads: []Ad{{
+				ID: "1",
+				InLine: &InLine{
+					AdSystem:    &AdSystem{Name: "DSP"},
+					AdTitle:     CDATAString{"title"},
+					Impressions: []Impression{{URI: "http://example.com/imp"}},
+					Creatives: []Creative{{
+						Linear: &Linear{
+							SkipOffset:     &Offset{Duration: &d},
+							TrackingEvents: TrackingEvents{{Event: Event_type_start, URI: "http://example.com/start"}},
+						},
+					}},
+				},
+			}},
+		}
+	}
+
+	assert.True(t, base().Equal(base()))
+	assert.True(t, (*VAST)(nil).Equal(nil))
+	assert.False(t, base().Equal(nil))
+
+	// whitespace around text
+	v := base()
+	v.Ads[0].InLine.AdSystem.Name = "\n  DSP "
+	v.Ads[0].InLine.AdTitle.CDATA = " title\t"
+	v.Ads[0].InLine.Impressions[0].URI = "\n http://example.com/imp\n"
+	v.Ads[0].InLine.Creatives[0].Linear.TrackingEvents[0].URI = " http://example.com/start "
+	assert.True(t, v.Equal(base()))
+	assert.True(t, base().Equal(v))
+
+	// nil and empty lists, absent and empty extensions
+	v = base()
+	v.Errors = []CDATAString{}
+	v.Ads[0].InLine.Extensions = &[]Extension{}
+	v.Ads[0].InLine.Creatives[0].Linear.MediaFiles = []MediaFile{}
+	assert.True(t, v.Equal(base()))
+	assert.True(t, base().Equal(v))
+
+	// pointers are compared by value
+	v = base()
+	v.Ads[0].InLine.Creatives[0].Linear.SkipOffset = &Offset{Duration: &d2}
+	assert.True(t, v.Equal(base()))
+
+	for name, change := range map[string]func(v *VAST){
+		"version":   func(v *VAST) { v.Version = "4.0" },
+		"ad":        func(v *VAST) { v.Ads = append(v.Ads, Ad{}) },
+		"wrapper":   func(v *VAST) { v.Ads[0].Wrapper = &Wrapper{} },
+		"text":      func(v *VAST) { v.Ads[0].InLine.AdTitle.CDATA = "other title" },
+		"inner":     func(v *VAST) { v.Ads[0].InLine.AdSystem.Name = "D SP" },
+		"attribute": func(v *VAST) { v.Ads[0].InLine.AdSystem.Version = " " },
+		"offset":    func(v *VAST) { v.Ads[0].InLine.Creatives[0].Linear.SkipOffset = &Offset{Percent: 0.1} },
+		"optional":  func(v *VAST) { v.Ads[0].InLine.Description = &CDATAString{} },
+		"extension": func(v *VAST) { v.Ads[0].InLine.Extensions = &[]Extension{{Data: "<a/>"}} },
+		"event":     func(v *VAST) { v.Ads[0].InLine.Creatives[0].Linear.TrackingEvents[0].Event = Event_type_complete },
+	} {
+		v := base()
+		change(v)
+		assert.False(t, v.Equal(base()), name)
+		assert.False(t, base().Equal(v), name)
+	}
+}
+
+func TestAdEqual(t *testing.T) {
+	yes, yes2 := true, true
+	a := &Ad{Wrapper: &Wrapper{VASTAdTagURI: CDATAString{"http://example.com/tag"}, FallbackOnNoAd: &yes}}
+	b := &Ad{Wrapper: &Wrapper{VASTAdTagURI: CDATAString{" http://example.com/tag\n"}, FallbackOnNoAd: &yes2, Impressions: []Impression{}}}
+	assert.True(t, a.Equal(b))
+	b.Wrapper.FallbackOnNoAd = nil
+	assert.False(t, a.Equal(b))
+}
@@ -0,0 +1,93 @@
+package vast
+
+import "fmt"
+
+// ErrorCode is a VAST error code, as reported to the error-tracking URIs
+// through the [ERRORCODE] macro.
+type ErrorCode int
+
+const (
+	// XML parsing error.
+	ErrorCodeXMLParsing ErrorCode = 100
+	// VAST schema validation error.
+	ErrorCodeSchemaValidation ErrorCode = 101
+	// VAST version of response not supported.
+	ErrorCodeVersionNotSupported ErrorCode = 102
+	// Trafficking error. Video player received an Ad type that it was not
+	// expecting and/or cannot display.
+	ErrorCodeTrafficking ErrorCode = 200
+	// Video player expecting different linearity.
+	ErrorCodeLinearity ErrorCode = 201
+	// Video player expecting different duration.
+	ErrorCodeDuration ErrorCode = 202
+	// Video player expecting different size.
+	ErrorCodeSize ErrorCode = 203
+	// Ad category was required but not provided.
+	ErrorCodeCategoryRequired ErrorCode = 204
+	// General Wrapper error.
+	ErrorCodeWrapper ErrorCode = 300
+	// Timeout of VAST URI provided in Wrapper element, or of VAST URI
+	// provided in a subsequent Wrapper element.
+	ErrorCodeWrapperTimeout ErrorCode = 301
+	// Wrapper limit reached, as defined by the video player.
+	ErrorCodeWrapperLimit ErrorCode = 302
+	// No VAST response after one or more Wrappers.
+	ErrorCodeNoAdAfterWrapper ErrorCode = 303
+	// InLine response returned ad unit that failed to result in ad display
+	// within defined time limit.
+	ErrorCodeInLineTimeout ErrorCode = 304
+	// General Linear error. Video player is unable to display the Linear Ad.
+	ErrorCodeLinear ErrorCode = 400
+	// File not found. Unable to find Linear/MediaFile from URI.
+	ErrorCodeFileNotFound ErrorCode = 401
+	// Timeout of MediaFile URI.
+	ErrorCodeMediaFileTimeout ErrorCode = 402
+	// Couldn't find MediaFile that is supported by this video player, based
+	// on the attributes of the MediaFile element.
+	ErrorCodeMediaFileNotSupported ErrorCode = 403
+	// Problem displaying MediaFile.
+	ErrorCodeMediaFileDisplay ErrorCode = 405
+	// Mezzanine was required but not provided.
+	ErrorCodeMezzanineRequired ErrorCode = 406
+	// Mezzanine is in the process of being downloaded for the first time.
+	ErrorCodeMezzanineDownloading ErrorCode = 407
+	// Conditional ad rejected.
+	ErrorCodeConditionalAdRejected ErrorCode = 408
+	// Interactive unit in the InteractiveCreativeFile node was not executed.
+	ErrorCodeInteractiveNotExecuted ErrorCode = 409
+	// Verification unit in the Verification node was not executed.
+	ErrorCodeVerificationNotExecuted ErrorCode = 410
+	// Mezzanine was provided but not in the required format.
+	ErrorCodeMezzanineFormat ErrorCode = 411
+	// General NonLinearAds error.
+	ErrorCodeNonLinear ErrorCode = 500
+	// Unable to display NonLinearAd because creative dimensions do not align
+	// with creative display area.
+	ErrorCodeNonLinearSize ErrorCode = 501
+	// Unable to fetch NonLinearAds/NonLinear resource.
+	ErrorCodeNonLinearFetch ErrorCode = 502
+	// Couldn't find NonLinear resource with supported type.
+	ErrorCodeNonLinearNotSupported ErrorCode = 503
+	// General CompanionAds error.
+	ErrorCodeCompanion ErrorCode = 600
+	// Unable to display Companion because creative dimensions do not fit
+	// within Companion display area.
+	ErrorCodeCompanionSize ErrorCode = 601
+	// Unable to display required Companion.
+	ErrorCodeCompanionRequired ErrorCode = 602
+	// Unable to fetch CompanionAds/Companion resource.
+	ErrorCodeCompanionFetch ErrorCode = 603
+	// Couldn't find Companion resource with supported type.
+	ErrorCodeCompanionNotSupported ErrorCode = 604
+	// Undefined Error.
+	ErrorCodeUndefined ErrorCode = 900
+	// General VPAID error.
+	ErrorCodeVPAID ErrorCode = 901
+	// General InteractiveCreativeFile error code.
+	ErrorCodeInteractiveCreativeFile ErrorCode = 902
+)
+
+// Error implements the error interface.
+func (c ErrorCode) Error() string {
+	return fmt.Sprintf("vast error %d", int(c))
+}
@@ -1,5 +1,15 @@
 package vast
 
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EventType is the name of the event a Tracking element tracks.
+type EventType string
+
 const (
 	/**
 	 * not to be confused with an impression, this event indicates that an individual creative
@@ -9,53 +19,53 @@ const (
 	 * platforms are more common.
 	 */
 
-	Event_type_creativeView = "creativeView"
-	Event_type_view         = "view"
+	Event_type_creativeView EventType = "creativeView"
+	Event_type_view         EventType = "view"
 
 	/**
 	 * this event is used to indicate that an individual creative within the ad was loaded and playback
 	 * began. As with creativeView, this event is another way of tracking creative playback.
 	 */
-	Event_type_start = "start"
+	Event_type_start EventType = "start"
 
 	// the creative played for at least 25% of the total duration.
-	Event_type_firstQuartile = "firstQuartile"
+	Event_type_firstQuartile EventType = "firstQuartile"
 
 	// the creative played for at least 50% of the total duration.
-	Event_type_midpoint = "midpoint"
+	Event_type_midpoint EventType = "midpoint"
 
 	// the creative played for at least 75% of the duration.
-	Event_type_thirdQuartile = "thirdQuartile"
+	Event_type_thirdQuartile EventType = "thirdQuartile"
 
 	// The creative was played to the end at normal speed.
-	Event_type_complete = "complete"
+	Event_type_complete EventType = "complete"
 
 	// the user activated the mute control and muted the creative.
-	Event_type_mute = "mute"
+	Event_type_mute EventType = "mute"
 
 	// the user activated the mute control and unmuted the creative.
-	Event_type_unmute = "unmute"
+	Event_type_unmute EventType = "unmute"
 
 	// the user clicked the pause control and stopped the creative.
-	Event_type_pause = "pause"
+	Event_type_pause EventType = "pause"
 
 	// the user activated the rewind control to access a previous point in the creative timeline.
-	Event_type_rewind = "rewind"
+	Event_type_rewind EventType = "rewind"
 
 	// the user activated the resume control after the creative had been stopped or paused.
-	Event_type_resume = "resume"
+	Event_type_resume EventType = "resume"
 
 	// the user activated a control to extend the video player to the edges of the viewer’s screen.
-	Event_type_fullscreen = "fullscreen"
+	Event_type_fullscreen EventType = "fullscreen"
 
 	// the user activated the control to reduce video player size to original dimensions.
-	Event_type_exitFullscreen = "exitFullscreen"
+	Event_type_exitFullscreen EventType = "exitFullscreen"
 
 	// the user activated a control to expand the creative.
-	Event_type_expand = "expand"
+	Event_type_expand EventType = "expand"
 
 	// the user activated a control to reduce the creative to its original dimensions.
-	Event_type_collapse = "collapse"
+	Event_type_collapse EventType = "collapse"
 
 	/**
 	 * the user activated a control that launched an additional portion of the
@@ -64,7 +74,7 @@ const (
 	 * metric as applying to non-linear ads only. The “acceptInvitationLinear” event extends the metric for use
 	 * in Linear creative.
 	 */
-	Event_type_acceptInvitationLinear = "acceptInvitationLinear"
+	Event_type_acceptInvitationLinear EventType = "acceptInvitationLinear"
 
 	/**
 	 * the user clicked the close button on the creative. The name of this event distinguishes it
@@ -72,20 +82,276 @@ const (
 	 * Definitions, which defines the “close” metric as applying to non-linear ads only. The “closeLinear” event
 	 * extends the “close” event for use in Linear creative.
 	 */
-	Event_type_closeLinear = "closeLinear"
+	Event_type_closeLinear EventType = "closeLinear"
 
-	Event_type_close = "close"
+	Event_type_close EventType = "close"
 
 	// the user activated a skip control to skip the creative, which is a
 	// different control than the one used to close the creative.
-	Event_type_skip = "skip"
+	Event_type_skip EventType = "skip"
 
 	/**
 	 * the creative played for a duration at normal speed that is equal to or greater than the
 	 * value provided in an additional attribute for offset . Offset values can be time in the format
 	 * HH:MM:SS or HH:MM:SS.mmm or a percentage value in the format n% . Multiple progress ev
 	 */
-	Event_type_progress = "progress"
+	Event_type_progress EventType = "progress"
+
+	Event_type_monitor EventType = "monitor"
+
+	// the user activated a control that launched an additional portion of a
+	// non-linear creative.
+	Event_type_acceptInvitation EventType = "acceptInvitation"
+
+	// the creative was loaded and buffered, and is ready to play. Added in VAST 4.0.
+	Event_type_loaded EventType = "loaded"
+
+	// the user activated a control to expand the player. Replaces fullscreen in VAST 4.1.
+	Event_type_playerExpand EventType = "playerExpand"
+
+	// the user activated a control to reduce the player. Replaces exitFullscreen in VAST 4.1.
+	Event_type_playerCollapse EventType = "playerCollapse"
+
+	// the user clicked or otherwise interacted with the ad, for example to
+	// access a menu of the creative, other than a click-through. Added in VAST 4.1.
+	Event_type_otherAdInteraction EventType = "otherAdInteraction"
+
+	// the ad was not played, for example because its creative could not be
+	// loaded or no slot was available. Added in VAST 4.1.
+	Event_type_notUsed EventType = "notUsed"
+
+	// the interactive creative file of the ad started. Added in VAST 4.1.
+	Event_type_interactiveStart EventType = "interactiveStart"
 
-	Event_type_monitor = "monitor"
+	// the user activated a control to expand a non-linear creative. Added in VAST 4.0.
+	Event_type_adExpand EventType = "adExpand"
+
+	// the user activated a control to reduce a non-linear creative. Added in VAST 4.0.
+	Event_type_adCollapse EventType = "adCollapse"
+
+	// the user activated a control to minimize a non-linear creative. Added in VAST 4.0.
+	Event_type_minimize EventType = "minimize"
+
+	// the non-linear creative was displayed for the duration given by the
+	// offset attribute. Added in VAST 4.0.
+	Event_type_overlayViewDuration EventType = "overlayViewDuration"
+
+	// the time the user viewed the creative. Added in VAST 4.0.
+	Event_type_timeSpentViewing EventType = "timeSpentViewing"
+)
+
+// EventTarget is a kind of creative tracking events apply to.
+type EventTarget uint8
+
+const (
+	// Linear creatives
+	EventTargetLinear EventTarget = 1 << iota
+	// Non-linear creatives
+	EventTargetNonLinear
+	// Companion creatives
+	EventTargetCompanion
 )
+
+// eventSpec is how the VAST specification defines an event.
+type eventSpec struct {
+	// the version the event was introduced and deprecated in, if any
+	since, deprecated string
+	targets           EventTarget
+}
+
+// eventSpecs are the events defined by the VAST specification up to 4.2.
+// The view and monitor events are not part of it.
+var eventSpecs = map[EventType]eventSpec{
+	Event_type_creativeView:           {"2.0", "", EventTargetLinear | EventTargetNonLinear | EventTargetCompanion},
+	Event_type_start:                  {"2.0", "", EventTargetLinear},
+	Event_type_firstQuartile:          {"2.0", "", EventTargetLinear},
+	Event_type_midpoint:               {"2.0", "", EventTargetLinear},
+	Event_type_thirdQuartile:          {"2.0", "", EventTargetLinear},
+	Event_type_complete:               {"2.0", "", EventTargetLinear},
+	Event_type_mute:                   {"2.0", "", EventTargetLinear},
+	Event_type_unmute:                 {"2.0", "", EventTargetLinear},
+	Event_type_pause:                  {"2.0", "", EventTargetLinear},
+	Event_type_rewind:                 {"2.0", "", EventTargetLinear},
+	Event_type_resume:                 {"2.0", "", EventTargetLinear},
+	Event_type_fullscreen:             {"2.0", "4.1", EventTargetLinear},
+	Event_type_exitFullscreen:         {"3.0", "4.1", EventTargetLinear},
+	Event_type_expand:                 {"2.0", "4.1", EventTargetLinear | EventTargetNonLinear},
+	Event_type_collapse:               {"2.0", "4.1", EventTargetLinear | EventTargetNonLinear},
+	Event_type_acceptInvitation:       {"2.0", "", EventTargetNonLinear},
+	Event_type_acceptInvitationLinear: {"3.0", "4.1", EventTargetLinear},
+	Event_type_close:                  {"2.0", "", EventTargetNonLinear},
+	Event_type_closeLinear:            {"3.0", "", EventTargetLinear},
+	Event_type_skip:                   {"3.0", "", EventTargetLinear},
+	Event_type_progress:               {"3.0", "", EventTargetLinear},
+	Event_type_loaded:                 {"4.0", "", EventTargetLinear},
+	Event_type_adExpand:               {"4.0", "", EventTargetNonLinear},
+	Event_type_adCollapse:             {"4.0", "", EventTargetNonLinear},
+	Event_type_minimize:               {"4.0", "", EventTargetNonLinear},
+	Event_type_overlayViewDuration:    {"4.0", "", EventTargetNonLinear},
+	Event_type_timeSpentViewing:       {"4.0", "", EventTargetLinear | EventTargetNonLinear},
+	Event_type_playerExpand:           {"4.1", "", EventTargetLinear},
+	Event_type_playerCollapse:         {"4.1", "", EventTargetLinear},
+	Event_type_otherAdInteraction:     {"4.1", "", EventTargetLinear | EventTargetNonLinear},
+	Event_type_notUsed:                {"4.1", "", EventTargetLinear | EventTargetNonLinear},
+	Event_type_interactiveStart:       {"4.1", "", EventTargetLinear},
+}
+
+// eventsByName are the events of the specification, along with the view and
+// monitor events, by lower cased name.
+var eventsByName = map[string]EventType{
+	strings.ToLower(string(Event_type_view)):    Event_type_view,
+	strings.ToLower(string(Event_type_monitor)): Event_type_monitor,
+}
+
+func init() {
+	for e := range eventSpecs {
+		eventsByName[strings.ToLower(string(e))] = e
+	}
+}
+
+// EventTypes returns the events defined by the VAST specification, sorted by
+// name.
+func EventTypes() []EventType {
+	events := make([]EventType, 0, len(eventSpecs))
+	for e := range eventSpecs {
+		events = append(events, e)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i] < events[j] })
+	return events
+}
+
+// ParseEventType returns the event of the VAST specification with the given
+// name. Unlike UnmarshalText, an error is returned for unknown events.
+func ParseEventType(name string) (EventType, error) {
+	e := EventType(name)
+	if _, ok := eventSpecs[e]; !ok {
+		return "", fmt.Errorf("vast: unknown tracking event %q", name)
+	}
+	return e, nil
+}
+
+// canonicalEvent returns the name of a known tracking event whatever its
+// case.
+func canonicalEvent(name string) (EventType, bool) {
+	e, ok := eventsByName[strings.ToLower(strings.TrimSpace(name))]
+	return e, ok
+}
+
+// String implements the fmt.Stringer interface.
+func (e EventType) String() string {
+	return string(e)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e EventType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Events
+// are kept as is, whether defined by the specification or not: custom and
+// malformed events are common, and rejecting them here would fail the whole
+// document for a single tracker. DecodeStrict validates them, and Validate or
+// ParseEventType check a single event.
+func (e *EventType) UnmarshalText(data []byte) error {
+	*e = EventType(data)
+	return nil
+}
+
+// Validate checks that the event is a non-empty name without spaces. Events
+// which are not defined by the specification are valid.
+func (e EventType) Validate() error {
+	if !validEventName(string(e)) {
+		return fmt.Errorf("vast: invalid tracking event %q", string(e))
+	}
+	return nil
+}
+
+func validEventName(s string) bool {
+	return s != "" && !strings.ContainsAny(s, " \t\r\n")
+}
+
+// Known returns whether the event is defined by the VAST specification.
+func (e EventType) Known() bool {
+	_, ok := eventSpecs[e]
+	return ok
+}
+
+// Since returns the version of the specification which introduced the
+// event, or an empty string for unknown events.
+func (e EventType) Since() string {
+	return eventSpecs[e].since
+}
+
+// Deprecated returns the version of the specification which deprecated the
+// event, if any.
+func (e EventType) Deprecated() string {
+	return eventSpecs[e].deprecated
+}
+
+// AvailableIn returns whether the event is defined by the given version of
+// the specification, e.g. "3.0" or "4.2". Deprecated events remain available.
+func (e EventType) AvailableIn(version string) bool {
+	s, ok := eventSpecs[e]
+	return ok && compareVersions(version, s.since) >= 0
+}
+
+// AppliesTo returns whether the event may be tracked for the given kind of
+// creative.
+func (e EventType) AppliesTo(t EventTarget) bool {
+	return eventSpecs[e].targets&t != 0
+}
+
+// compareVersions compares two VAST versions, such as "2.0" and "4.1".
+func compareVersions(a, b string) int {
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var na, nb int
+		if i < len(pa) {
+			na, _ = strconv.Atoi(strings.TrimSpace(pa[i]))
+		}
+		if i < len(pb) {
+			nb, _ = strconv.Atoi(strings.TrimSpace(pb[i]))
+		}
+		if na != nb {
+			if na < nb {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// TrackingEvents is a list of tracking URLs.
+type TrackingEvents []Tracking
+
+// ByEvent returns the trackings of the given event, in order.
+func (ts TrackingEvents) ByEvent(e EventType) []Tracking {
+	var res []Tracking
+	for _, t := range ts {
+		if t.Event == e {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// Group returns the trackings by event.
+func (ts TrackingEvents) Group() map[EventType][]Tracking {
+	res := make(map[EventType][]Tracking)
+	for _, t := range ts {
+		res[t.Event] = append(res[t.Event], t)
+	}
+	return res
+}
+
+// URIs returns the URLs to ping for the given event.
+func (ts TrackingEvents) URIs(e EventType) []string {
+	var res []string
+	for _, t := range ts {
+		if t.Event == e {
+			res = append(res, t.URI)
+		}
+	}
+	return res
+}
@@ -0,0 +1,112 @@
+package vast
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventTypeSpec(t *testing.T) {
+	assert.True(t, Event_type_loaded.Known())
+	assert.False(t, Event_type_monitor.Known())
+	assert.False(t, EventType("viewable_impression").Known())
+
+	assert.Equal(t, "4.1", Event_type_playerExpand.Since())
+	assert.Equal(t, "4.1", Event_type_fullscreen.Deprecated())
+	assert.Equal(t, "", Event_type_start.Deprecated())
+	assert.Equal(t, "", EventType("custom").Since())
+
+	assert.True(t, Event_type_start.AvailableIn("2.0"))
+	assert.True(t, Event_type_fullscreen.AvailableIn("4.2"))
+	assert.False(t, Event_type_progress.AvailableIn("2.0"))
+	assert.True(t, Event_type_progress.AvailableIn("3.0"))
+	assert.False(t, Event_type_notUsed.AvailableIn("4.0"))
+	assert.True(t, Event_type_notUsed.AvailableIn("4.2"))
+	assert.False(t, EventType("custom").AvailableIn("4.2"))
+
+	assert.True(t, Event_type_creativeView.AppliesTo(EventTargetCompanion))
+	assert.True(t, Event_type_start.AppliesTo(EventTargetLinear))
+	assert.False(t, Event_type_start.AppliesTo(EventTargetNonLinear|EventTargetCompanion))
+	assert.True(t, Event_type_minimize.AppliesTo(EventTargetNonLinear))
+	assert.False(t, Event_type_minimize.AppliesTo(EventTargetLinear))
+
+	events := EventTypes()
+	assert.Len(t, events, 32)
+	assert.Equal(t, Event_type_acceptInvitation, events[0])
+	for _, e := range events {
+		assert.NotEmpty(t, e.Since(), e)
+		assert.NotZero(t, eventSpecs[e].targets, e)
+	}
+}
+
+func TestParseEventType(t *testing.T) {
+	e, err := ParseEventType("interactiveStart")
+	assert.NoError(t, err)
+	assert.Equal(t, Event_type_interactiveStart, e)
+
+	_, err = ParseEventType("Start")
+	assert.EqualError(t, err, `vast: unknown tracking event "Start"`)
+
+	e, ok := canonicalEvent(" OverlayViewDuration ")
+	assert.True(t, ok)
+	assert.Equal(t, Event_type_overlayViewDuration, e)
+	e, ok = canonicalEvent("VIEW")
+	assert.True(t, ok)
+	assert.Equal(t, Event_type_view, e)
+	e, ok = canonicalEvent("Monitor")
+	assert.True(t, ok)
+	assert.Equal(t, Event_type_monitor, e)
+}
+
+func TestEventTypeText(t *testing.T) {
+	var tr Tracking
+	assert.NoError(t, xml.Unmarshal([]byte(`<Tracking event="linearChange"><![CDATA[http://example.com]]></Tracking>`), &tr))
+	assert.Equal(t, EventType("linearChange"), tr.Event)
+
+	assert.NoError(t, tr.Event.Validate())
+
+	assert.NoError(t, xml.Unmarshal([]byte(`<Tracking event=""><![CDATA[http://example.com]]></Tracking>`), &tr))
+	assert.Equal(t, EventType(""), tr.Event)
+	assert.EqualError(t, tr.Event.Validate(), `vast: invalid tracking event ""`)
+	assert.NoError(t, xml.Unmarshal([]byte(`<Tracking event="start "><![CDATA[http://example.com]]></Tracking>`), &tr))
+	assert.Equal(t, EventType("start "), tr.Event)
+	assert.EqualError(t, tr.Event.Validate(), `vast: invalid tracking event "start "`)
+	assert.EqualError(t, EventType("first quartile").Validate(), `vast: invalid tracking event "first quartile"`)
+
+	// strict decoding fails on invalid events only
+	doc := `<VAST version="4.2"><Ad><InLine><Creatives><Creative><Linear><TrackingEvents>` +
+		`<Tracking event="customEvent"><![CDATA[http://example.com]]></Tracking>` +
+		`<Tracking event="%s"><![CDATA[http://example.com]]></Tracking>` +
+		`</TrackingEvents></Linear></Creative></Creatives></InLine></Ad></VAST>`
+	var v VAST
+	if assert.NoError(t, UnmarshalStrict([]byte(fmt.Sprintf(doc, "start")), &v)) {
+		assert.Len(t, v.Ads[0].InLine.Creatives[0].Linear.TrackingEvents, 2)
+	}
+	assert.EqualError(t, UnmarshalStrict([]byte(fmt.Sprintf(doc, "start ")), &v), `vast: invalid tracking event "start "`)
+	assert.NoError(t, Unmarshal([]byte(fmt.Sprintf(doc, "start ")), &v))
+
+	b, err := xml.Marshal(Tracking{Event: Event_type_adCollapse, URI: "http://example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, `<Tracking event="adCollapse"><![CDATA[http://example.com]]></Tracking>`, string(b))
+
+	b, err = xml.Marshal(Tracking{URI: "http://example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, `<Tracking event=""><![CDATA[http://example.com]]></Tracking>`, string(b))
+}
+
+func TestTrackingEvents(t *testing.T) {
+	ts := TrackingEvents{
+		{Event: Event_type_start, URI: "http://example.com/start1"},
+		{Event: Event_type_complete, URI: "http://example.com/complete"},
+		{Event: Event_type_start, URI: "http://example.com/start2"},
+	}
+	assert.Equal(t, []Tracking{ts[0], ts[2]}, ts.ByEvent(Event_type_start))
+	assert.Nil(t, ts.ByEvent(Event_type_pause))
+	assert.Equal(t, []string{"http://example.com/start1", "http://example.com/start2"}, ts.URIs(Event_type_start))
+	assert.Equal(t, map[EventType][]Tracking{
+		Event_type_start:    {ts[0], ts[2]},
+		Event_type_complete: {ts[1]},
+	}, ts.Group())
+}
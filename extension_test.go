@@ -45,10 +45,10 @@ func TestExtensionCustomTracking(t *testing.T) {
 	assert.Empty(t, string(e.Data))
 	if assert.Len(t, e.CustomTracking, 2) {
 		// first event
-		assert.Equal(t, "event.1", e.CustomTracking[0].Event)
+		assert.Equal(t, EventType("event.1"), e.CustomTracking[0].Event)
 		assert.Equal(t, "http://event.1", e.CustomTracking[0].URI)
 		// second event
-		assert.Equal(t, "event.2", e.CustomTracking[1].Event)
+		assert.Equal(t, EventType("event.2"), e.CustomTracking[1].Event)
 		assert.Equal(t, "http://event.2", e.CustomTracking[1].URI)
 	}
 
@@ -4,7 +4,9 @@ go 1.13
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/pquerna/ffjson v0.0.0-20190930134022-aa0246cd15f7
 	github.com/stretchr/testify v1.5.1
+	golang.org/x/net v0.0.0-20220722155237-a158d28d115b
+	golang.org/x/text v0.3.8
+	google.golang.org/protobuf v1.28.1
 	gopkg.in/yaml.v2 v2.2.8 // indirect
 )
@@ -0,0 +1,82 @@
+package vast
+
+import (
+	"math"
+	"strings"
+)
+
+// IconProgramAdChoices is the program of the AdChoices icons.
+const IconProgramAdChoices = "AdChoices"
+
+// isAdChoices reports whether the program of an icon is AdChoices. "DAA",
+// the organization running the AdChoices program, is used by some ad servers.
+func isAdChoices(program string) bool {
+	return strings.EqualFold(program, IconProgramAdChoices) || strings.EqualFold(program, "DAA")
+}
+
+// pxRatio returns the pixel ratio of the icon, defaulting to 1.
+func (icon *Icon) pxRatio() float64 {
+	if icon.PxRatio <= 0 {
+		return 1
+	}
+	return icon.PxRatio
+}
+
+// AdChoices returns the AdChoices icon best suited for a screen of the given
+// pixel ratio, along with the click fallback image to display on devices
+// which cannot open the IconClickThrough. The icon whose pxratio is the
+// closest to the screen one is selected, the first one on ties. The fallback
+// image is nil when the icon does not provide any.
+func (icons *Icons) AdChoices(pxratio float64) (*Icon, *IconClickFallbackImage) {
+	if icons == nil {
+		return nil, nil
+	}
+	if pxratio <= 0 {
+		pxratio = 1
+	}
+	var best *Icon
+	for i := range icons.Icon {
+		icon := &icons.Icon[i]
+		if !isAdChoices(icon.Program) {
+			continue
+		}
+		if best == nil || math.Abs(icon.pxRatio()-pxratio) < math.Abs(best.pxRatio()-pxratio) {
+			best = icon
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+	return best, best.FallbackImage(pxratio)
+}
+
+// FallbackImage returns the click fallback image best suited for a screen of
+// the given pixel ratio: the smallest image at least as wide as the icon
+// rendered at that ratio or, when none is, the widest one.
+func (icon *Icon) FallbackImage(pxratio float64) *IconClickFallbackImage {
+	if icon.IconClicks == nil || icon.IconClicks.IconClickFallbackImages == nil {
+		return nil
+	}
+	images := icon.IconClicks.IconClickFallbackImages.IconClickFallbackImage
+	if len(images) == 0 {
+		return nil
+	}
+	if pxratio <= 0 {
+		pxratio = 1
+	}
+	target := int(math.Ceil(float64(icon.Width) * pxratio / icon.pxRatio()))
+	var fit, widest *IconClickFallbackImage
+	for i := range images {
+		img := &images[i]
+		if img.Width >= target && (fit == nil || img.Width < fit.Width) {
+			fit = img
+		}
+		if widest == nil || img.Width > widest.Width {
+			widest = img
+		}
+	}
+	if fit != nil {
+		return fit
+	}
+	return widest
+}
@@ -0,0 +1,105 @@
+package vast
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var iconsXML = []byte(`<Icons>` +
+	`<Icon program="AdChoices" width="20" height="20" xPosition="right" yPosition="top" pxratio="1">` +
+	`<StaticResource creativeType="image/png"><![CDATA[https://example.com/adchoices.png]]></StaticResource>` +
+	`<IconClicks>` +
+	`<IconClickThrough><![CDATA[https://example.com/info]]></IconClickThrough>` +
+	`<IconClickTracking id="1"><![CDATA[https://example.com/click]]></IconClickTracking>` +
+	`<IconClickFallbackImages>` +
+	`<IconClickFallbackImage width="400" height="300"><AltText>Why this ad?</AltText><StaticResource creativeType="image/png"><![CDATA[https://example.com/fallback_400.png]]></StaticResource></IconClickFallbackImage>` +
+	`<IconClickFallbackImage width="800" height="600"><AltText>Why this ad?</AltText><StaticResource><![CDATA[https://example.com/fallback_800.png]]></StaticResource></IconClickFallbackImage>` +
+	`</IconClickFallbackImages>` +
+	`</IconClicks>` +
+	`<IconViewTracking><![CDATA[https://example.com/view1]]></IconViewTracking>` +
+	`<IconViewTracking><![CDATA[https://example.com/view2]]></IconViewTracking>` +
+	`</Icon>` +
+	`<Icon program="AdChoices" width="40" height="40" xPosition="right" yPosition="top" pxratio="2">` +
+	`<StaticResource creativeType="image/png"><![CDATA[https://example.com/adchoices@2x.png]]></StaticResource>` +
+	`</Icon>` +
+	`</Icons>`)
+
+func TestIconsUnmarshal(t *testing.T) {
+	var icons Icons
+	if !assert.NoError(t, xml.Unmarshal(iconsXML, &icons)) {
+		return
+	}
+	if assert.Len(t, icons.Icon, 2) {
+		icon := icons.Icon[0]
+		assert.Nil(t, icon.Offset)
+		assert.Nil(t, icon.Duration)
+		assert.Equal(t, 1.0, icon.PxRatio)
+		if assert.NotNil(t, icon.IconClicks) {
+			clicks := icon.IconClicks
+			assert.Equal(t, "https://example.com/info", clicks.IconClickThrough.CDATA)
+			if assert.Len(t, clicks.IconClickTrackings, 1) {
+				assert.Equal(t, "1", clicks.IconClickTrackings[0].ID)
+				assert.Equal(t, "https://example.com/click", clicks.IconClickTrackings[0].URI)
+			}
+			if assert.NotNil(t, clicks.IconClickFallbackImages) && assert.Len(t, clicks.IconClickFallbackImages.IconClickFallbackImage, 2) {
+				img := clicks.IconClickFallbackImages.IconClickFallbackImage[0]
+				assert.Equal(t, 400, img.Width)
+				assert.Equal(t, 300, img.Height)
+				assert.Equal(t, "Why this ad?", img.AltText)
+				assert.Equal(t, "https://example.com/fallback_400.png", img.StaticResource.URI)
+				assert.Equal(t, "image/png", img.StaticResource.CreativeType)
+			}
+		}
+		assert.Len(t, icon.IconViewTrackings, 2)
+	}
+
+	b, err := xml.Marshal(icons)
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(iconsXML), string(b))
+	}
+}
+
+func TestIconOptionalAttributes(t *testing.T) {
+	b, err := xml.Marshal(Icon{Width: 10, Height: 10, XPosition: "left", YPosition: "top"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `<Icon width="10" height="10" xPosition="left" yPosition="top"></Icon>`, string(b))
+	}
+}
+
+func TestIconClicksOptionalElements(t *testing.T) {
+	b, err := xml.Marshal(IconClicks{IconClickThrough: &CDATAString{CDATA: "https://example.com/info"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `<IconClicks><IconClickThrough><![CDATA[https://example.com/info]]></IconClickThrough></IconClicks>`, string(b))
+	}
+}
+
+func TestIconsAdChoices(t *testing.T) {
+	var icons Icons
+	if !assert.NoError(t, xml.Unmarshal(iconsXML, &icons)) {
+		return
+	}
+
+	icon, img := icons.AdChoices(1)
+	if assert.NotNil(t, icon) && assert.NotNil(t, img) {
+		assert.Equal(t, 1.0, icon.PxRatio)
+		assert.Equal(t, 400, img.Width)
+	}
+
+	icon, img = icons.AdChoices(3)
+	if assert.NotNil(t, icon) {
+		assert.Equal(t, 2.0, icon.PxRatio)
+		assert.Nil(t, img)
+	}
+
+	img = icons.Icon[0].FallbackImage(30)
+	if assert.NotNil(t, img) {
+		assert.Equal(t, 800, img.Width)
+	}
+
+	var none *Icons
+	icon, img = none.AdChoices(1)
+	assert.Nil(t, icon)
+	assert.Nil(t, img)
+}
@@ -0,0 +1,108 @@
+package vast
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// The JSON representation of the VAST types follows their Go definition:
+//
+//   - objects are keyed by the name of the struct fields, e.g. "Ads",
+//     "Errors" or "TrackingEvents", and empty lists and optional fields are
+//     omitted
+//   - text elements, such as CDATAString, are plain strings
+//   - Duration and Offset are strings in the VAST format, e.g. "00:00:15",
+//     "00:00:05.250" or "25%"
+//   - enumerations, such as EventType or CompanionsRequired, are strings
+//   - the raw XML of an Extension is kept as a string in "Data"
+//
+// Documents round-trip losslessly between XML and JSON. The representation
+// of previous versions, where text elements were {"Data": "..."} objects and
+// the ads and error URLs were keyed "Ad" and "Error", is still accepted when
+// decoding.
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c CDATAString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.CDATA)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *CDATAString) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var legacy struct{ Data string }
+		if err := json.Unmarshal(data, &legacy); err != nil {
+			return err
+		}
+		c.CDATA = legacy.Data
+		return nil
+	}
+	return json.Unmarshal(data, &c.CDATA)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *AdSystem) UnmarshalJSON(data []byte) error {
+	type adSystem AdSystem
+	aux := struct {
+		*adSystem
+		Data *string
+	}{adSystem: (*adSystem)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.Data != nil && s.Name == "" {
+		s.Name = *aux.Data
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *VAST) UnmarshalJSON(data []byte) error {
+	type vast VAST
+	aux := struct {
+		*vast
+		Ad    []Ad
+		Error []CDATAString
+	}{vast: (*vast)(v)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if v.Ads == nil {
+		v.Ads = aux.Ad
+	}
+	if v.Errors == nil {
+		v.Errors = aux.Error
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (in *InLine) UnmarshalJSON(data []byte) error {
+	type inLine InLine
+	aux := struct {
+		*inLine
+		Error []CDATAString
+	}{inLine: (*inLine)(in)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if in.Errors == nil {
+		in.Errors = aux.Error
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (w *Wrapper) UnmarshalJSON(data []byte) error {
+	type wrapper Wrapper
+	aux := struct {
+		*wrapper
+		Error []CDATAString
+	}{wrapper: (*wrapper)(w)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if w.Errors == nil {
+		w.Errors = aux.Error
+	}
+	return nil
+}
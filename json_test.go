@@ -0,0 +1,113 @@
+package vast
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files of testdata/json")
+
+func TestJSONGolden(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".xml")
+		t.Run(name, func(t *testing.T) {
+			b, err := ioutil.ReadFile(file)
+			if !assert.NoError(t, err) {
+				return
+			}
+			var v VAST
+			if err := Unmarshal(b, &v); err != nil {
+				t.Skipf("invalid document: %v", err)
+			}
+			got, err := json.MarshalIndent(v, "", "  ")
+			if !assert.NoError(t, err) {
+				return
+			}
+			golden := filepath.Join("testdata", "json", name+".json")
+			if *update {
+				if !assert.NoError(t, ioutil.WriteFile(golden, append(got, '\n'), 0644)) {
+					return
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if assert.NoError(t, err) {
+				assert.Equal(t, string(want), string(got)+"\n")
+			}
+
+			// lossless round trip
+			var v2 VAST
+			if assert.NoError(t, json.Unmarshal(got, &v2)) {
+				assert.Equal(t, v, v2)
+				x1, _ := xml.Marshal(v)
+				x2, _ := xml.Marshal(v2)
+				assert.Equal(t, string(x1), string(x2))
+			}
+		})
+	}
+}
+
+func TestJSONLegacy(t *testing.T) {
+	legacy := `{"Version":"3.0","xmlns":"http://www.iab.com/VAST","Ad":[{"ID":"1","InLine":{"AdSystem":{"Version":"1","Data":"DSP"},"AdTitle":{"Data":"title"},"Error":[{"Data":"http://example.com/error"}]}},{"ID":"2","Wrapper":{"VASTAdTagURI":{"Data":"http://example.com/tag"},"Error":[{"Data":"http://example.com/error2"}]}}],"Errors":[{"Data":"http://example.com/noad"}]}`
+	var v VAST
+	if !assert.NoError(t, json.Unmarshal([]byte(legacy), &v)) {
+		return
+	}
+	assert.Equal(t, VAST{
+		Version: "3.0",
+		XMLNS:   "http://www.iab.com/VAST",
+		Ads: []Ad{
+			{ID: "1", InLine: &InLine{
+				AdSystem: &AdSystem{Version: "1", Name: "DSP"},
+				AdTitle:  CDATAString{CDATA: "title"},
+				Errors:   []CDATAString{{CDATA: "http://example.com/error"}},
+			}},
+			{ID: "2", Wrapper: &Wrapper{
+				VASTAdTagURI: CDATAString{CDATA: "http://example.com/tag"},
+				Errors:       []CDATAString{{CDATA: "http://example.com/error2"}},
+			}},
+		},
+		Errors: []CDATAString{{CDATA: "http://example.com/noad"}},
+	}, v)
+
+	b, err := json.Marshal(v)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"Version":"3.0","XMLNS":"http://www.iab.com/VAST","Ads":[{"InLine":{"AdSystem":{"Version":"1","Name":"DSP"},"Errors":["http://example.com/error"],"AdTitle":"title"},"ID":"1"},{"Wrapper":{"Errors":["http://example.com/error2"],"VASTAdTagURI":"http://example.com/tag"},"ID":"2"}],"Errors":["http://example.com/noad"]}`, string(b))
+	}
+}
+
+func TestJSONTextTypes(t *testing.T) {
+	d := Duration(5*time.Second + 250*time.Millisecond)
+	v := Linear{
+		SkipOffset: &Offset{Percent: 0.29},
+		Duration:   d,
+		TrackingEvents: TrackingEvents{
+			{Event: Event_type_progress, Offset: &Offset{Duration: &d}, URI: "http://example.com/progress"},
+		},
+	}
+	b, err := json.Marshal(v)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, `{"SkipOffset":"29%","TrackingEvents":[{"Event":"progress","Offset":"00:00:05.250","URI":"http://example.com/progress"}],"Duration":"00:00:05.250"}`, string(b))
+
+	// decoding into a used value replaces it
+	v2 := Linear{Duration: Duration(time.Hour), SkipOffset: &Offset{Duration: &d}}
+	if assert.NoError(t, json.NewDecoder(bytes.NewReader(b)).Decode(&v2)) {
+		assert.Equal(t, d, v2.Duration)
+		assert.Nil(t, v2.SkipOffset.Duration)
+		assert.Equal(t, float32(0.29), v2.SkipOffset.Percent)
+	}
+}
@@ -0,0 +1,346 @@
+package vast
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Warning describes a repair made while decoding a document leniently.
+type Warning struct {
+	// The path of the repaired element, e.g. VAST>Ad>InLine>Creatives>Creative>Linear>Duration
+	Path string
+	// What was repaired
+	Message string
+}
+
+// String implements the fmt.Stringer interface.
+func (w Warning) String() string {
+	return w.Path + ": " + w.Message
+}
+
+// DecodeLenient reads a VAST document from r like Decode, repairing the
+// common mistakes of ad servers instead of failing or keeping invalid values:
+//
+//   - durations expressed in seconds (15) or as mm:ss (00:15)
+//   - boolean attributes such as "TRUE", "1" or "yes"
+//   - tracking events with the wrong case (FirstQuartile)
+//   - URLs surrounded by whitespace or new lines
+//   - ads containing both an <InLine> and a <Wrapper>, the <Wrapper> being
+//     dropped
+//
+// Every repair is described by a returned warning.
+func DecodeLenient(r io.Reader) (*VAST, []Warning, error) {
+	ur, err := newUTF8Reader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := ioutil.ReadAll(ur)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, warnings, err := repair(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.CharsetReader = utf8CharsetReader
+	var v VAST
+	if err := dec.Decode(&v); err != nil {
+		return nil, nil, err
+	}
+	return &v, warnings, nil
+}
+
+// UnmarshalLenient parses a VAST document leniently, see DecodeLenient.
+func UnmarshalLenient(data []byte, v *VAST) ([]Warning, error) {
+	res, warnings, err := DecodeLenient(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	*v = *res
+	return warnings, nil
+}
+
+// urlElements are the elements whose content is a URL.
+var urlElements = map[string]bool{
+	"Impression": true, "Error": true, "VASTAdTagURI": true, "Tracking": true,
+	"ClickThrough": true, "ClickTracking": true, "CustomClick": true,
+	"MediaFile": true, "Mezzanine": true, "InteractiveCreativeFile": true,
+	"StaticResource": true, "IFrameResource": true, "JavaScriptResource": true,
+	"CompanionClickThrough": true, "CompanionClickTracking": true,
+	"NonLinearClickThrough": true, "NonLinearClickTracking": true,
+	"IconClickThrough": true, "IconClickTracking": true, "IconViewTracking": true,
+}
+
+// durationAttrs are the attributes holding a Duration, or an Offset which
+// may be a Duration.
+var durationAttrs = map[string]bool{
+	"skipoffset": true, "offset": true, "duration": true, "minSuggestedDuration": true,
+}
+
+// boolAttrs are the attributes holding a boolean.
+var boolAttrs = map[string]bool{
+	"mute": true, "fallbackOnNoAd": true, "allowMultipleAds": true,
+	"followAdditionalWrappers": true, "scalable": true,
+	"maintainAspectRatio": true, "xmlEncoded": true,
+}
+
+// edit replaces the bytes between start and end.
+type edit struct {
+	start, end int64
+	text       string
+}
+
+// element is an element being scanned by repair.
+type element struct {
+	name string
+	// offset of the start of the element and of its content
+	start, content int64
+	// the text content of the element, for the ones which may be repaired
+	text   *strings.Builder
+	simple bool
+	// the ranges of the <InLine> and <Wrapper> children of an <Ad>
+	inline, wrapper *[2]int64
+}
+
+// repair scans a UTF-8 document and returns it with the mistakes listed in
+// DecodeLenient fixed.
+func repair(data []byte) ([]byte, []Warning, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.CharsetReader = utf8CharsetReader
+
+	var (
+		edits    []edit
+		warnings []Warning
+		stack    []*element
+	)
+	path := func(name string) string {
+		names := make([]string, 0, len(stack)+1)
+		for _, e := range stack {
+			names = append(names, e.name)
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+		return strings.Join(names, ">")
+	}
+	warn := func(p, format string, args ...interface{}) {
+		warnings = append(warnings, Warning{Path: p, Message: fmt.Sprintf(format, args...)})
+	}
+
+	for {
+		offset := dec.InputOffset()
+		tok, err := dec.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			el := &element{name: t.Name.Local, start: offset, content: dec.InputOffset()}
+			if repaired, ok := repairAttrs(t, path(el.name), warn); ok {
+				raw := data[offset:dec.InputOffset()]
+				edits = append(edits, edit{offset, dec.InputOffset(), startTag(repaired, bytes.HasSuffix(raw, []byte("/>")))})
+			}
+			if urlElements[el.name] || el.name == "Duration" {
+				el.text = &strings.Builder{}
+				el.simple = true
+			}
+			stack = append(stack, el)
+		case xml.CharData:
+			if n := len(stack); n > 0 && stack[n-1].text != nil {
+				stack[n-1].text.Write(t)
+			}
+		case xml.EndElement:
+			n := len(stack)
+			if n == 0 {
+				break
+			}
+			el := stack[n-1]
+			stack = stack[:n-1]
+			p := path(el.name)
+			if el.text != nil && el.simple {
+				s := el.text.String()
+				repaired, ok := s, false
+				if el.name == "Duration" {
+					repaired, ok = repairDuration(s)
+					if ok {
+						warn(p, "invalid duration %q repaired as %q", strings.TrimSpace(s), repaired)
+					}
+				} else if trimmed := strings.TrimSpace(s); trimmed != s && trimmed != "" {
+					repaired, ok = trimmed, true
+					warn(p, "whitespace trimmed around URL %q", trimmed)
+				}
+				if ok {
+					edits = append(edits, edit{el.content, offset, cdata(repaired)})
+				}
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				// elements nested in the repaired ones are not expected
+				parent.simple = false
+				if parent.name == "Ad" {
+					switch el.name {
+					case "InLine":
+						parent.inline = &[2]int64{el.start, dec.InputOffset()}
+					case "Wrapper":
+						parent.wrapper = &[2]int64{el.start, dec.InputOffset()}
+					}
+				}
+			}
+			if el.name == "Ad" && el.inline != nil && el.wrapper != nil {
+				warn(p, "ad contains both an InLine and a Wrapper, Wrapper dropped")
+				w := el.wrapper
+				kept := edits[:0]
+				for _, e := range edits {
+					if e.start < w[0] || e.end > w[1] {
+						kept = append(kept, e)
+					}
+				}
+				edits = append(kept, edit{w[0], w[1], ""})
+			}
+		}
+	}
+	if len(edits) == 0 {
+		return data, warnings, nil
+	}
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+	var buf bytes.Buffer
+	last := int64(0)
+	for _, e := range edits {
+		buf.Write(data[last:e.start])
+		buf.WriteString(e.text)
+		last = e.end
+	}
+	buf.Write(data[last:])
+	return buf.Bytes(), warnings, nil
+}
+
+// repairAttrs repairs the attributes of an element, returning false when
+// none had to be.
+func repairAttrs(t xml.StartElement, p string, warn func(p, format string, args ...interface{})) (xml.StartElement, bool) {
+	changed := false
+	attrs := make([]xml.Attr, 0, len(t.Attr))
+	for _, a := range t.Attr {
+		name := a.Name.Local
+		switch {
+		case a.Name.Space == "" && boolAttrs[name]:
+			if _, err := strconv.ParseBool(a.Value); err == nil {
+				break
+			}
+			b, ok := parseLenientBool(a.Value)
+			if !ok {
+				warn(p, "invalid boolean %s=%q dropped", name, a.Value)
+				changed = true
+				continue
+			}
+			v := strconv.FormatBool(b)
+			warn(p, "boolean %s=%q repaired as %q", name, a.Value, v)
+			a.Value = v
+			changed = true
+		case a.Name.Space == "" && durationAttrs[name] && !strings.HasSuffix(strings.TrimSpace(a.Value), "%"):
+			if v, ok := repairDuration(a.Value); ok {
+				warn(p, "invalid duration %s=%q repaired as %q", name, a.Value, v)
+				a.Value = v
+				changed = true
+			}
+		case a.Name.Space == "" && name == "event" && t.Name.Local == "Tracking":
+			if v, ok := canonicalEvent(a.Value); ok && string(v) != a.Value {
+				warn(p, "event %q repaired as %q", a.Value, v)
+				a.Value = string(v)
+				changed = true
+			}
+		}
+		attrs = append(attrs, a)
+	}
+	t.Attr = attrs
+	return t, changed
+}
+
+// startTag returns the XML of a start element read with RawToken.
+func startTag(t xml.StartElement, selfClosing bool) string {
+	var b strings.Builder
+	b.WriteString("<" + qualifiedName(t.Name))
+	for _, a := range t.Attr {
+		b.WriteString(" " + qualifiedName(a.Name) + `="`)
+		xml.EscapeText(&b, []byte(a.Value))
+		b.WriteString(`"`)
+	}
+	if selfClosing {
+		b.WriteString("/>")
+	} else {
+		b.WriteString(">")
+	}
+	return b.String()
+}
+
+func qualifiedName(n xml.Name) string {
+	if n.Space == "" {
+		return n.Local
+	}
+	return n.Space + ":" + n.Local
+}
+
+// cdata returns s as a CDATA section.
+func cdata(s string) string {
+	return "<![CDATA[" + strings.Replace(s, "]]>", "]]]]><![CDATA[>", -1) + "]]>"
+}
+
+// parseLenientBool parses the usual representations of booleans.
+func parseLenientBool(s string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "1", "t", "yes", "y", "on":
+		return true, true
+	case "false", "0", "f", "no", "n", "off":
+		return false, true
+	}
+	return false, false
+}
+
+var (
+	secondsPattern = regexp.MustCompile(`^(\d+)(\.\d{1,3})?$`)
+	minutesPattern = regexp.MustCompile(`^(\d{1,2}):(\d{1,2})(\.\d{1,3})?$`)
+)
+
+// repairDuration converts durations expressed in seconds or as mm:ss to the
+// hh:mm:ss format, returning false for valid or unrecognized durations.
+func repairDuration(s string) (string, bool) {
+	var d Duration
+	if d.UnmarshalText([]byte(s)) == nil {
+		return s, false
+	}
+	s = strings.TrimSpace(s)
+	var sec, ms string
+	var min int
+	if m := secondsPattern.FindStringSubmatch(s); m != nil {
+		sec, ms = m[1], m[2]
+	} else if m := minutesPattern.FindStringSubmatch(s); m != nil {
+		min, _ = strconv.Atoi(m[1])
+		sec, ms = m[2], m[3]
+	} else {
+		return s, false
+	}
+	n, err := strconv.Atoi(sec)
+	if err != nil {
+		return s, false
+	}
+	d = Duration(time.Duration(min)*time.Minute + time.Duration(n)*time.Second)
+	if ms != "" {
+		// .5 is 500ms
+		v, _ := strconv.Atoi((ms[1:] + "00")[:3])
+		d += Duration(time.Duration(v) * time.Millisecond)
+	}
+	b, _ := d.MarshalText()
+	return string(b), true
+}
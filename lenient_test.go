@@ -0,0 +1,155 @@
+package vast
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const lenientXML = `<?xml version="1.0" encoding="UTF-8"?>
+<VAST version="3.0" mute="yes">
+	<Ad id="1">
+		<InLine>
+			<AdSystem>DSP</AdSystem>
+			<AdTitle>title</AdTitle>
+			<Impression>
+				http://example.com/impression
+			</Impression>
+			<Creatives>
+				<Creative>
+					<Linear skipoffset="5">
+						<Duration>00:15</Duration>
+						<TrackingEvents>
+							<Tracking event="FirstQuartile"><![CDATA[http://example.com/q1]]></Tracking>
+							<Tracking event="progress" offset="10.5"><![CDATA[ http://example.com/progress ]]></Tracking>
+							<Tracking event="customEvent"><![CDATA[http://example.com/custom]]></Tracking>
+						</TrackingEvents>
+						<MediaFiles>
+							<MediaFile delivery="progressive" type="video/mp4" width="640" height="360" scalable="Y" maintainAspectRatio="maybe">
+								<![CDATA[http://example.com/video.mp4]]>
+							</MediaFile>
+						</MediaFiles>
+					</Linear>
+				</Creative>
+			</Creatives>
+			<Extensions><Extension type="x"><Custom attr="1"> kept as is </Custom></Extension></Extensions>
+		</InLine>
+		<Wrapper fallbackOnNoAd="Yes">
+			<VASTAdTagURI><![CDATA[http://example.com/tag]]></VASTAdTagURI>
+		</Wrapper>
+	</Ad>
+</VAST>`
+
+func TestDecodeLenient(t *testing.T) {
+	// strict decoding fails on the boolean
+	_, err := Decode(strings.NewReader(lenientXML))
+	assert.EqualError(t, err, `strconv.ParseBool: parsing "yes": invalid syntax`)
+
+	v, warnings, err := DecodeLenient(strings.NewReader(lenientXML))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, v.Mute)
+	if assert.Len(t, v.Ads, 1) {
+		ad := v.Ads[0]
+		assert.Nil(t, ad.Wrapper)
+		if assert.NotNil(t, ad.InLine) {
+			assert.Equal(t, "http://example.com/impression", ad.InLine.Impressions[0].URI)
+			linear := ad.InLine.Creatives[0].Linear
+			assert.Equal(t, Duration(15*time.Second), linear.Duration)
+			if assert.NotNil(t, linear.SkipOffset) && assert.NotNil(t, linear.SkipOffset.Duration) {
+				assert.Equal(t, Duration(5*time.Second), *linear.SkipOffset.Duration)
+			}
+			if assert.Len(t, linear.TrackingEvents, 3) {
+				assert.Equal(t, Event_type_firstQuartile, linear.TrackingEvents[0].Event)
+				assert.Equal(t, "http://example.com/progress", linear.TrackingEvents[1].URI)
+				if assert.NotNil(t, linear.TrackingEvents[1].Offset) {
+					assert.Equal(t, Duration(10500*time.Millisecond), *linear.TrackingEvents[1].Offset.Duration)
+				}
+				assert.Equal(t, EventType("customEvent"), linear.TrackingEvents[2].Event)
+			}
+			mf := linear.MediaFiles[0]
+			assert.Equal(t, "http://example.com/video.mp4", mf.URI)
+			assert.True(t, mf.Scalable)
+			assert.False(t, mf.MaintainAspectRatio)
+			assert.Equal(t, `<Custom attr="1"> kept as is </Custom>`, (*ad.InLine.Extensions)[0].Data)
+		}
+	}
+
+	var messages []string
+	for _, w := range warnings {
+		messages = append(messages, w.String())
+	}
+	assert.Equal(t, []string{
+		`VAST: boolean mute="yes" repaired as "true"`,
+		`VAST>Ad>InLine>Impression: whitespace trimmed around URL "http://example.com/impression"`,
+		`VAST>Ad>InLine>Creatives>Creative>Linear: invalid duration skipoffset="5" repaired as "00:00:05"`,
+		`VAST>Ad>InLine>Creatives>Creative>Linear>Duration: invalid duration "00:15" repaired as "00:00:15"`,
+		`VAST>Ad>InLine>Creatives>Creative>Linear>TrackingEvents>Tracking: event "FirstQuartile" repaired as "firstQuartile"`,
+		`VAST>Ad>InLine>Creatives>Creative>Linear>TrackingEvents>Tracking: invalid duration offset="10.5" repaired as "00:00:10.500"`,
+		`VAST>Ad>InLine>Creatives>Creative>Linear>TrackingEvents>Tracking: whitespace trimmed around URL "http://example.com/progress"`,
+		`VAST>Ad>InLine>Creatives>Creative>Linear>MediaFiles>MediaFile: boolean scalable="Y" repaired as "true"`,
+		`VAST>Ad>InLine>Creatives>Creative>Linear>MediaFiles>MediaFile: invalid boolean maintainAspectRatio="maybe" dropped`,
+		`VAST>Ad>InLine>Creatives>Creative>Linear>MediaFiles>MediaFile: whitespace trimmed around URL "http://example.com/video.mp4"`,
+		`VAST>Ad>Wrapper: boolean fallbackOnNoAd="Yes" repaired as "true"`,
+		`VAST>Ad: ad contains both an InLine and a Wrapper, Wrapper dropped`,
+	}, messages)
+}
+
+func TestDecodeLenientExtraSpaces(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/extraspaces_vpaid.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	var v VAST
+	warnings, err := UnmarshalLenient(b, &v)
+	if assert.NoError(t, err) {
+		mf := v.Ads[0].InLine.Creatives[0].Linear.MediaFiles[0]
+		assert.Equal(t, "https://dummy.com/dummmy.js", mf.URI)
+		assert.Len(t, warnings, 1)
+	}
+}
+
+func TestDecodeLenientValidDocuments(t *testing.T) {
+	for _, path := range []string{
+		"testdata/vast_inline_linear.xml",
+		"testdata/vast_wrapper_linear_2.xml",
+		"testdata/creative_extensions.xml",
+	} {
+		b, err := ioutil.ReadFile(path)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		strict, err := Decode(bytes.NewReader(b))
+		if !assert.NoError(t, err) {
+			continue
+		}
+		lenient, warnings, err := DecodeLenient(bytes.NewReader(b))
+		if assert.NoError(t, err, path) {
+			assert.Empty(t, warnings, path)
+			assert.Equal(t, strict, lenient, path)
+		}
+	}
+}
+
+func TestRepairDuration(t *testing.T) {
+	for in, want := range map[string]string{
+		"15":     "00:00:15",
+		" 90 ":   "00:01:30",
+		"00:15":  "00:00:15",
+		"1:05.5": "00:01:05.500",
+		"15.25":  "00:00:15.250",
+	} {
+		got, ok := repairDuration(in)
+		assert.True(t, ok, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"00:00:15", "abc", "", "undefined"} {
+		_, ok := repairDuration(in)
+		assert.False(t, ok, in)
+	}
+}
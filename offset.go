@@ -2,8 +2,10 @@ package vast
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Offset represents either a vast.Duration or a percentage of the video duration.
@@ -19,11 +21,13 @@ func (o Offset) MarshalText() ([]byte, error) {
 	if o.Duration != nil {
 		return o.Duration.MarshalText()
 	}
-	return []byte(fmt.Sprintf("%d%%", int(o.Percent*100))), nil
+	// rounded, 0.29 being stored as 0.28999999
+	return []byte(fmt.Sprintf("%d%%", int(math.Round(float64(o.Percent)*100)))), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 func (o *Offset) UnmarshalText(data []byte) error {
+	*o = Offset{}
 	if strings.HasSuffix(string(data), "%") {
 		p, err := strconv.ParseInt(string(data[:len(data)-1]), 10, 8)
 		if err != nil {
@@ -36,3 +40,13 @@ func (o *Offset) UnmarshalText(data []byte) error {
 	o.Duration = &d
 	return o.Duration.UnmarshalText(data)
 }
+
+// Resolve returns the time of the offset in a creative of the given duration,
+// percentages being rounded to the millisecond.
+func (o Offset) Resolve(d Duration) Duration {
+	if o.Duration != nil {
+		return *o.Duration
+	}
+	ms := math.Round(float64(d) / float64(time.Millisecond) * float64(o.Percent))
+	return Duration(ms) * Duration(time.Millisecond)
+}
@@ -2,6 +2,7 @@ package vast
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -43,3 +44,12 @@ func TestOffsetUnmarshaler(t *testing.T) {
 	o = Offset{}
 	assert.EqualError(t, o.UnmarshalText([]byte("abc%")), "invalid offset: abc%")
 }
+
+func TestOffsetResolve(t *testing.T) {
+	d := Duration(16 * time.Second)
+	assert.Equal(t, Duration(1600*time.Millisecond), Offset{Percent: 0.1}.Resolve(d))
+	assert.Equal(t, Duration(4*time.Second), Offset{Percent: 0.25}.Resolve(d))
+	assert.Equal(t, Duration(0), Offset{}.Resolve(d))
+	five := Duration(5 * time.Second)
+	assert.Equal(t, five, Offset{Duration: &five}.Resolve(d))
+}
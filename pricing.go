@@ -0,0 +1,183 @@
+package vast
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// PricingModel is the pricing model of a Pricing.
+type PricingModel string
+
+const (
+	// Cost per thousand impressions.
+	PricingModelCPM PricingModel = "cpm"
+	// Cost per click.
+	PricingModelCPC PricingModel = "cpc"
+	// Cost per engagement.
+	PricingModelCPE PricingModel = "cpe"
+	// Cost per view.
+	PricingModelCPV PricingModel = "cpv"
+)
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Values are
+// case insensitive, unknown ones are kept as is.
+func (m *PricingModel) UnmarshalText(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	switch v := PricingModel(strings.ToLower(s)); v {
+	case PricingModelCPM, PricingModelCPC, PricingModelCPE, PricingModelCPV:
+		*m = v
+	default:
+		*m = PricingModel(s)
+	}
+	return nil
+}
+
+// Valid reports whether the model is one of the models defined by VAST.
+func (m PricingModel) Valid() bool {
+	switch m {
+	case PricingModelCPM, PricingModelCPC, PricingModelCPE, PricingModelCPV:
+		return true
+	}
+	return false
+}
+
+// ValidCurrency reports whether code is an ISO-4217 currency code, such as
+// "USD" or "EUR".
+func ValidCurrency(code string) bool {
+	if len(code) != 3 || strings.ToUpper(code) != code {
+		return false
+	}
+	_, err := currency.ParseISO(code)
+	return err == nil
+}
+
+// Validate checks the model and the currency of the pricing. The value is
+// not checked, as it may be obfuscated.
+func (p *Pricing) Validate() error {
+	if !p.Model.Valid() {
+		return fmt.Errorf("vast: invalid pricing model %q", p.Model)
+	}
+	if !ValidCurrency(p.Currency) {
+		return fmt.Errorf("vast: invalid pricing currency %q", p.Currency)
+	}
+	return nil
+}
+
+var decimalRegexp = regexp.MustCompile(`^(\d+(\.\d*)?|\.\d+)$`)
+
+// Amount parses the value of the pricing as a decimal number, such as
+// "25.00". Exponents, signs and the other float syntaxes are rejected.
+func (p *Pricing) Amount() (float64, error) {
+	return parseAmount(p.Value)
+}
+
+// SetAmount sets the value of the pricing to the decimal form of amount.
+func (p *Pricing) SetAmount(amount float64) {
+	p.Value = strconv.FormatFloat(amount, 'f', -1, 64)
+}
+
+func parseAmount(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if !decimalRegexp.MatchString(s) {
+		return 0, fmt.Errorf("vast: invalid pricing value %q", s)
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// PriceCrypter encrypts and decrypts the obfuscated values of pricing, using
+// the mechanism negotiated between publishers and advertisers.
+type PriceCrypter interface {
+	// EncryptPrice returns the obfuscated value of a price.
+	EncryptPrice(amount float64) (string, error)
+	// DecryptPrice returns the price of an obfuscated value.
+	DecryptPrice(value string) (float64, error)
+}
+
+// DecryptAmount returns the price of an obfuscated value.
+func (p *Pricing) DecryptAmount(c PriceCrypter) (float64, error) {
+	return c.DecryptPrice(strings.TrimSpace(p.Value))
+}
+
+// EncryptAmount sets the value of the pricing to the obfuscated form of
+// amount.
+func (p *Pricing) EncryptAmount(amount float64, c PriceCrypter) error {
+	s, err := c.EncryptPrice(amount)
+	if err != nil {
+		return err
+	}
+	p.Value = s
+	return nil
+}
+
+// errPriceCiphertext is returned when decrypting an invalid value.
+var errPriceCiphertext = errors.New("vast: invalid encrypted price")
+
+// AESPriceCrypter obfuscates prices with AES-GCM: the values are the URL-safe
+// base64 encoding, without padding, of the nonce followed by the sealed
+// decimal price.
+type AESPriceCrypter struct {
+	aead cipher.AEAD
+}
+
+// NewAESPriceCrypter returns a crypter using key, of 16, 24 or 32 bytes.
+func NewAESPriceCrypter(key []byte) (*AESPriceCrypter, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &AESPriceCrypter{aead: aead}, nil
+}
+
+// EncryptPrice implements the PriceCrypter interface.
+func (c *AESPriceCrypter) EncryptPrice(amount float64) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	plain := strconv.FormatFloat(amount, 'f', -1, 64)
+	return base64.RawURLEncoding.EncodeToString(c.aead.Seal(nonce, nonce, []byte(plain), nil)), nil
+}
+
+// DecryptPrice implements the PriceCrypter interface.
+func (c *AESPriceCrypter) DecryptPrice(value string) (float64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(b) < c.aead.NonceSize() {
+		return 0, errPriceCiphertext
+	}
+	n := c.aead.NonceSize()
+	plain, err := c.aead.Open(nil, b[:n], b[n:], nil)
+	if err != nil {
+		return 0, errPriceCiphertext
+	}
+	return parseAmount(string(plain))
+}
+
+// ChainPricing returns the pricing to consider for a chain of ads, from the
+// first wrapper to the inline ad: only the pricing of the first ad of the
+// chain defining one is considered, the downstream ones are ignored.
+func ChainPricing(chain []*Ad) *Pricing {
+	for _, ad := range chain {
+		switch {
+		case ad == nil:
+		case ad.Wrapper != nil && ad.Wrapper.Pricing != nil:
+			return ad.Wrapper.Pricing
+		case ad.InLine != nil && ad.InLine.Pricing != nil:
+			return ad.InLine.Pricing
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package vast
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPricingUnmarshal(t *testing.T) {
+	var p Pricing
+	if assert.NoError(t, xml.Unmarshal([]byte(`<Pricing model="CPM" currency="EUR"><![CDATA[ 2.50 ]]></Pricing>`), &p)) {
+		assert.Equal(t, PricingModelCPM, p.Model)
+		assert.NoError(t, p.Validate())
+		amount, err := p.Amount()
+		if assert.NoError(t, err) {
+			assert.Equal(t, 2.5, amount)
+		}
+	}
+	p = Pricing{}
+	if assert.NoError(t, xml.Unmarshal([]byte(`<Pricing model="flat" currency="EUR">1</Pricing>`), &p)) {
+		assert.Equal(t, PricingModel("flat"), p.Model)
+		assert.Error(t, p.Validate())
+	}
+}
+
+func TestPricingValidate(t *testing.T) {
+	for _, c := range []string{"USD", "EUR", "CHF", "JPY"} {
+		assert.True(t, ValidCurrency(c), c)
+		assert.NoError(t, (&Pricing{Model: PricingModelCPV, Currency: c}).Validate())
+	}
+	for _, c := range []string{"", "usd", "US", "USDD", "ABC", "€"} {
+		assert.False(t, ValidCurrency(c), c)
+		assert.Error(t, (&Pricing{Model: PricingModelCPM, Currency: c}).Validate(), c)
+	}
+}
+
+func TestPricingAmount(t *testing.T) {
+	for value, amount := range map[string]float64{
+		"25.00":         25,
+		"\n 25.00 \n":   25,
+		"0.5":           0.5,
+		".5":            0.5,
+		"3.":            3,
+		"1234567.89012": 1234567.89012,
+	} {
+		a, err := (&Pricing{Value: value}).Amount()
+		if assert.NoError(t, err, value) {
+			assert.Equal(t, amount, a, value)
+		}
+	}
+	for _, value := range []string{"", "-1", "+1", "1e3", "0x10", "NaN", "Inf", "1,5", "abc"} {
+		_, err := (&Pricing{Value: value}).Amount()
+		assert.Error(t, err, value)
+	}
+
+	var p Pricing
+	p.SetAmount(12.75)
+	assert.Equal(t, "12.75", p.Value)
+}
+
+func TestAESPriceCrypter(t *testing.T) {
+	c, err := NewAESPriceCrypter([]byte("0123456789abcdef"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	p := &Pricing{Model: PricingModelCPM, Currency: "USD"}
+	if !assert.NoError(t, p.EncryptAmount(4.2, c)) {
+		return
+	}
+	assert.NotContains(t, p.Value, "4.2")
+	amount, err := p.DecryptAmount(c)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 4.2, amount)
+	}
+
+	// the encrypted value survives XML
+	out, err := xml.Marshal(p)
+	if assert.NoError(t, err) {
+		var p2 Pricing
+		if assert.NoError(t, xml.Unmarshal(out, &p2)) {
+			amount, err = p2.DecryptAmount(c)
+			if assert.NoError(t, err) {
+				assert.Equal(t, 4.2, amount)
+			}
+		}
+	}
+
+	other, _ := NewAESPriceCrypter([]byte("fedcba9876543210"))
+	_, err = p.DecryptAmount(other)
+	assert.Error(t, err)
+	_, err = c.DecryptPrice("4.2")
+	assert.Error(t, err)
+	_, err = c.DecryptPrice("")
+	assert.Error(t, err)
+
+	_, err = NewAESPriceCrypter([]byte("short"))
+	assert.Error(t, err)
+}
+
+func TestChainPricing(t *testing.T) {
+	first := &Pricing{Model: PricingModelCPM, Currency: "USD", Value: "5"}
+	second := &Pricing{Model: PricingModelCPM, Currency: "USD", Value: "3"}
+	inline := &Pricing{Model: PricingModelCPM, Currency: "USD", Value: "1"}
+	chain := []*Ad{
+		{Wrapper: &Wrapper{Pricing: first}},
+		{Wrapper: &Wrapper{Pricing: second}},
+		{InLine: &InLine{Pricing: inline}},
+	}
+	assert.Equal(t, first, ChainPricing(chain))
+	chain[0].Wrapper.Pricing = nil
+	assert.Equal(t, second, ChainPricing(chain))
+	assert.Equal(t, inline, ChainPricing(chain[2:]))
+	assert.Nil(t, ChainPricing([]*Ad{{Wrapper: &Wrapper{}}, nil}))
+	assert.Nil(t, ChainPricing(nil))
+
+	// the pricing of the fixtures is parsed
+	v, _, _, err := loadFixture("testdata/vast4_universal_ad_id.xml")
+	if assert.NoError(t, err) {
+		p := ChainPricing([]*Ad{&v.Ads[0]})
+		if assert.NotNil(t, p) {
+			assert.NoError(t, p.Validate())
+			amount, err := p.Amount()
+			if assert.NoError(t, err) {
+				assert.Equal(t, 25.0, amount)
+			}
+		}
+	}
+}
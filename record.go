@@ -0,0 +1,177 @@
+package vast
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// recordIndex is the name of the file listing the recorded responses.
+const recordIndex = "index.json"
+
+// Recording is a response saved by a RecordingFetcher.
+type Recording struct {
+	// The requested URL
+	URI string `json:"uri"`
+	// The file holding the body of the response, relative to the directory
+	File string `json:"file,omitempty"`
+	// The HTTP headers of the response, if any
+	Header http.Header `json:"header,omitempty"`
+	// The error returned by the fetcher, if any
+	Error string `json:"error,omitempty"`
+}
+
+// RecordingFetcher is a Fetcher saving the responses of another one to a
+// directory, typically to capture a wrapper chain in production and replay it
+// in a test with a ReplayFetcher.
+//
+// The bodies are saved in numbered files, e.g. 001.xml, and listed by
+// request order in an index.json file.
+type RecordingFetcher struct {
+	// The fetcher performing the requests
+	Fetcher Fetcher
+	// The directory the responses are saved to, created when needed
+	Dir string
+
+	mu         sync.Mutex
+	recordings []Recording
+}
+
+// Fetch implements the Fetcher interface.
+func (f *RecordingFetcher) Fetch(ctx context.Context, uri string) (*TagResponse, error) {
+	resp, fetchErr := f.Fetcher.Fetch(ctx, uri)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	rec := Recording{URI: uri}
+	if fetchErr != nil {
+		rec.Error = fetchErr.Error()
+	} else {
+		rec.File = fmt.Sprintf("%03d.xml", len(f.recordings)+1)
+		rec.Header = resp.Header
+	}
+	if err := os.MkdirAll(f.Dir, 0755); err != nil {
+		return nil, err
+	}
+	if rec.File != "" {
+		if err := ioutil.WriteFile(filepath.Join(f.Dir, rec.File), resp.Body, 0644); err != nil {
+			return nil, err
+		}
+	}
+	f.recordings = append(f.recordings, rec)
+	b, err := json.MarshalIndent(f.recordings, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(f.Dir, recordIndex), b, 0644); err != nil {
+		return nil, err
+	}
+	return resp, fetchErr
+}
+
+// Recordings returns the responses saved so far.
+func (f *RecordingFetcher) Recordings() []Recording {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Recording(nil), f.recordings...)
+}
+
+// ReplayFetcher is a Fetcher serving the responses saved by a
+// RecordingFetcher, without network access.
+//
+// Requests are matched on their URL or, when it differs, e.g. because of a
+// cache buster, on their normalized URL and then on their URL without query.
+// Each response is served once, in the recorded order, whichever way it was
+// matched: once all the responses matching a request were served, the last
+// one is repeated.
+type ReplayFetcher struct {
+	dir        string
+	mu         sync.Mutex
+	recordings []Recording
+	// whether each recording was served
+	served []bool
+	// the indexes of the recordings, by key
+	keys map[string][]int
+}
+
+// NewReplayFetcher returns a ReplayFetcher serving the responses saved in dir.
+func NewReplayFetcher(dir string) (*ReplayFetcher, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, recordIndex))
+	if err != nil {
+		return nil, err
+	}
+	var recs []Recording
+	if err := json.Unmarshal(b, &recs); err != nil {
+		return nil, err
+	}
+	f := &ReplayFetcher{
+		dir:        dir,
+		recordings: recs,
+		served:     make([]bool, len(recs)),
+		keys:       make(map[string][]int),
+	}
+	for i, rec := range recs {
+		for _, key := range replayKeys(rec.URI) {
+			f.keys[key] = append(f.keys[key], i)
+		}
+	}
+	return f, nil
+}
+
+// replayKeys returns the keys a request is matched on, by order of
+// preference.
+func replayKeys(uri string) []string {
+	keys := []string{uri, "normalized:" + NormalizeAdTagURI(uri)}
+	if u, err := url.Parse(uri); err == nil {
+		u.RawQuery = ""
+		u.Fragment = ""
+		keys = append(keys, "path:"+u.String())
+	}
+	return keys
+}
+
+// Fetch implements the Fetcher interface.
+func (f *ReplayFetcher) Fetch(ctx context.Context, uri string) (*TagResponse, error) {
+	rec, ok := f.next(replayKeys(uri))
+	if !ok {
+		return nil, fmt.Errorf("vast: no recorded response for %s", uri)
+	}
+	if rec.Error != "" {
+		return nil, errors.New(rec.Error)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(f.dir, rec.File))
+	if err != nil {
+		return nil, err
+	}
+	return &TagResponse{Body: b, Header: rec.Header}, nil
+}
+
+// next returns, for the first key which has recordings, the first one not
+// served yet, or the last one when all were served: a request matching a
+// recording exactly is not served the recording of another request of the
+// same path.
+func (f *ReplayFetcher) next(keys []string) (Recording, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, key := range keys {
+		idx := f.keys[key]
+		if len(idx) == 0 {
+			continue
+		}
+		for _, i := range idx {
+			if !f.served[i] {
+				f.served[i] = true
+				return f.recordings[i], true
+			}
+		}
+		return f.recordings[idx[len(idx)-1]], true
+	}
+	return Recording{}, false
+}
@@ -0,0 +1,130 @@
+package vast
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vast-record")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	wrapper, _ := ioutil.ReadFile("testdata/vast_wrapper_linear_1.xml")
+	inline, _ := ioutil.ReadFile("testdata/vast_inline_linear.xml")
+	responses := map[string]*TagResponse{
+		"http://example.com/wrapper?cb=123": {Body: wrapper, Header: http.Header{"Cache-Control": {"max-age=60"}}},
+		"http://example.com/inline":         {Body: inline},
+	}
+	f := &RecordingFetcher{
+		Dir: dir,
+		Fetcher: fetcherFunc(func(ctx context.Context, uri string) (*TagResponse, error) {
+			if resp, ok := responses[uri]; ok {
+				return resp, nil
+			}
+			return nil, errors.New("unexpected status fetching " + uri + ": 404 Not Found")
+		}),
+	}
+	ctx := context.Background()
+	for _, uri := range []string{"http://example.com/wrapper?cb=123", "http://example.com/inline", "http://example.com/missing"} {
+		f.Fetch(ctx, uri)
+	}
+	assert.Equal(t, []Recording{
+		{URI: "http://example.com/wrapper?cb=123", File: "001.xml", Header: http.Header{"Cache-Control": {"max-age=60"}}},
+		{URI: "http://example.com/inline", File: "002.xml"},
+		{URI: "http://example.com/missing", Error: "unexpected status fetching http://example.com/missing: 404 Not Found"},
+	}, f.Recordings())
+	b, err := ioutil.ReadFile(filepath.Join(dir, "002.xml"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, inline, b)
+	}
+
+	r, err := NewReplayFetcher(dir)
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the cache buster differs from the recorded one
+	resp, err := r.Fetch(ctx, "http://example.com/wrapper?cb=456")
+	if assert.NoError(t, err) {
+		assert.Equal(t, wrapper, resp.Body)
+		assert.Equal(t, "max-age=60", resp.Header.Get("Cache-Control"))
+	}
+	resp, err = r.Fetch(ctx, "http://example.com/inline")
+	if assert.NoError(t, err) {
+		assert.Equal(t, inline, resp.Body)
+	}
+	_, err = r.Fetch(ctx, "http://example.com/missing")
+	assert.EqualError(t, err, "unexpected status fetching http://example.com/missing: 404 Not Found")
+	_, err = r.Fetch(ctx, "http://example.com/other")
+	assert.EqualError(t, err, "vast: no recorded response for http://example.com/other")
+
+	// replayed documents can be cached like fetched ones
+	c := NewCache(10)
+	v, err := c.Load(ctx, r, "http://example.com/wrapper?cb=789")
+	if assert.NoError(t, err) {
+		assert.NotNil(t, v.Ads[0].Wrapper)
+		_, ok := c.Get("http://example.com/wrapper?cb=1")
+		assert.False(t, ok)
+	}
+}
+
+func TestReplayOrder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vast-replay")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	f := &RecordingFetcher{
+		Dir: dir,
+		Fetcher: fetcherFunc(func(ctx context.Context, uri string) (*TagResponse, error) {
+			return &TagResponse{Body: []byte(uri)}, nil
+		}),
+	}
+	ctx := context.Background()
+	f.Fetch(ctx, "http://example.com/tag?cb=1")
+	f.Fetch(ctx, "http://example.com/tag?cb=2")
+
+	r, err := NewReplayFetcher(dir)
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, tt := range []struct{ uri, body string }{
+		{"http://example.com/tag?cb=1", "http://example.com/tag?cb=1"},
+		// the first response was served by its exact URL
+		{"http://example.com/tag?cb=3", "http://example.com/tag?cb=2"},
+		// all were served, the last one matching is repeated
+		{"http://example.com/tag?cb=4", "http://example.com/tag?cb=2"},
+		{"http://example.com/tag?cb=1", "http://example.com/tag?cb=1"},
+	} {
+		resp, err := r.Fetch(ctx, tt.uri)
+		if assert.NoError(t, err, tt.uri) {
+			assert.Equal(t, tt.body, string(resp.Body), tt.uri)
+		}
+	}
+
+	// an exact match is repeated rather than serving another URL of the path
+	r, err = NewReplayFetcher(dir)
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, tt := range []struct{ uri, body string }{
+		{"http://example.com/tag?cb=1", "http://example.com/tag?cb=1"},
+		{"http://example.com/tag?cb=1", "http://example.com/tag?cb=1"},
+		{"http://example.com/tag?cb=2", "http://example.com/tag?cb=2"},
+	} {
+		resp, err := r.Fetch(ctx, tt.uri)
+		if assert.NoError(t, err, tt.uri) {
+			assert.Equal(t, tt.body, string(resp.Body), tt.uri)
+		}
+	}
+}
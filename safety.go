@@ -0,0 +1,489 @@
+package vast
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// SafetyIssueKind is the kind of dangerous construct found in a resource.
+type SafetyIssueKind string
+
+const (
+	// A <script> element without src, an event handler attribute such as
+	// onclick, or an iframe srcdoc document holding one.
+	SafetyIssueInlineScript SafetyIssueKind = "inline-script"
+	// A javascript: URL in a URL attribute or a meta refresh, or as the URL
+	// of a resource.
+	SafetyIssueJavaScriptURL SafetyIssueKind = "javascript-url"
+	// An <iframe> element loading a URL which is not https:, such as a http:
+	// or data: URL.
+	SafetyIssueInsecureIFrame SafetyIssueKind = "insecure-iframe"
+	// A StaticResource, IFrameResource or icon fallback image whose URL is not
+	// https:.
+	SafetyIssueInsecureResource SafetyIssueKind = "insecure-resource"
+	// A tag left unterminated at the end of a snippet, always rejected.
+	SafetyIssueUnterminatedTag SafetyIssueKind = "unterminated-tag"
+)
+
+// SafetyIssue is a dangerous construct found in a resource.
+type SafetyIssue struct {
+	// The path of the resource, e.g. Ads[0].InLine.Creatives[1].CompanionAds.Companions[0].HTMLResource,
+	// empty when checking a standalone HTML snippet.
+	Path string
+	Kind SafetyIssueKind
+	// The offending markup or URL, truncated
+	Detail string
+}
+
+// String implements the fmt.Stringer interface.
+func (i SafetyIssue) String() string {
+	s := string(i.Kind) + " " + i.Detail
+	if i.Path != "" {
+		s = i.Path + ": " + s
+	}
+	return s
+}
+
+// SafetyPolicy tells which constructs of the third party resources are
+// accepted. The zero value is the strictest policy, rejecting inline
+// scripts, javascript: URLs, non-HTTPS iframes and non-HTTPS static and
+// iframe resources.
+//
+// The policy applies to the HTMLResource, IFrameResource, StaticResource and
+// AdParameters of the companions, non linear ads and icons, the
+// xmlEncoded resources being decoded first. Scripts loaded from a URL are
+// accepted: they are the norm for HTML ads.
+type SafetyPolicy struct {
+	// Accept the <script> elements without src and the event handler
+	// attributes.
+	AllowInlineScripts bool
+	// Accept the javascript: URLs.
+	AllowJavaScriptURLs bool
+	// Accept the <iframe> elements loading a URL which is not https:.
+	AllowInsecureIFrames bool
+	// Accept the StaticResource, IFrameResource and icon fallback images
+	// whose URL is not https:.
+	AllowInsecureResources bool
+}
+
+// CheckHTML reports the constructs of an HTML snippet rejected by the policy.
+func (p SafetyPolicy) CheckHTML(markup string) []SafetyIssue {
+	_, issues := p.scanHTML(markup, false)
+	return issues
+}
+
+// SanitizeHTML removes the constructs of an HTML snippet rejected by the
+// policy: inline scripts and insecure iframes are removed with their content,
+// event handlers and javascript: URLs are removed from the attributes. The
+// removed constructs are reported.
+func (p SafetyPolicy) SanitizeHTML(markup string) (string, []SafetyIssue) {
+	return p.scanHTML(markup, true)
+}
+
+// Check reports the constructs of the resources of the document rejected by
+// the policy.
+func (p SafetyPolicy) Check(v *VAST) []SafetyIssue {
+	s := safetyScan{policy: p}
+	s.document(v)
+	return s.issues
+}
+
+// Sanitize removes the constructs of the resources of the document rejected
+// by the policy, see SanitizeHTML, and the static and iframe resources with
+// a rejected URL. The document is modified in place: Clone it first when it
+// is shared. The removed constructs are reported.
+func (p SafetyPolicy) Sanitize(v *VAST) []SafetyIssue {
+	s := safetyScan{policy: p, strip: true}
+	s.document(v)
+	return s.issues
+}
+
+// safetyScan walks the resources of a document.
+type safetyScan struct {
+	policy SafetyPolicy
+	strip  bool
+	issues []SafetyIssue
+}
+
+func (s *safetyScan) document(v *VAST) {
+	if v == nil {
+		return
+	}
+	for i := range v.Ads {
+		ad := &v.Ads[i]
+		if ad.InLine != nil {
+			for j := range ad.InLine.Creatives {
+				s.creative(fmt.Sprintf("Ads[%d].InLine.Creatives[%d]", i, j), &ad.InLine.Creatives[j])
+			}
+		}
+		if ad.Wrapper != nil {
+			for j := range ad.Wrapper.Creatives {
+				s.creativeWrapper(fmt.Sprintf("Ads[%d].Wrapper.Creatives[%d]", i, j), &ad.Wrapper.Creatives[j])
+			}
+		}
+	}
+}
+
+func (s *safetyScan) creative(path string, cr *Creative) {
+	if cr.Linear != nil {
+		s.adParameters(path+".Linear.AdParameters", cr.Linear.AdParameters)
+		s.icons(path+".Linear.Icons", cr.Linear.Icons)
+	}
+	if cr.CompanionAds != nil {
+		for i := range cr.CompanionAds.Companions {
+			c := &cr.CompanionAds.Companions[i]
+			p := fmt.Sprintf("%s.CompanionAds.Companions[%d]", path, i)
+			s.resources(p, c.HTMLResource, &c.IFrameResource, &c.StaticResource)
+			s.adParameters(p+".AdParameters", c.AdParameters)
+		}
+	}
+	if cr.NonLinearAds != nil {
+		for i := range cr.NonLinearAds.NonLinears {
+			nl := &cr.NonLinearAds.NonLinears[i]
+			p := fmt.Sprintf("%s.NonLinearAds.NonLinears[%d]", path, i)
+			s.resources(p, nl.HTMLResource, &nl.IFrameResource, &nl.StaticResource)
+			s.adParameters(p+".AdParameters", nl.AdParameters)
+		}
+	}
+}
+
+func (s *safetyScan) creativeWrapper(path string, cr *CreativeWrapper) {
+	if cr.Linear != nil {
+		s.icons(path+".Linear.Icons", cr.Linear.Icons)
+	}
+	if cr.CompanionAds != nil {
+		for i := range cr.CompanionAds.Companions {
+			c := &cr.CompanionAds.Companions[i]
+			p := fmt.Sprintf("%s.CompanionAds.Companions[%d]", path, i)
+			s.resources(p, c.HTMLResource, &c.IFrameResource, &c.StaticResource)
+			s.adParameters(p+".AdParameters", c.AdParameters)
+		}
+	}
+}
+
+func (s *safetyScan) icons(path string, icons *Icons) {
+	if icons == nil {
+		return
+	}
+	for i := range icons.Icon {
+		icon := &icons.Icon[i]
+		p := fmt.Sprintf("%s.Icon[%d]", path, i)
+		s.resources(p, icon.HTMLResource, &icon.IFrameResource, &icon.StaticResource)
+		if icon.IconClicks == nil || icon.IconClicks.IconClickFallbackImages == nil {
+			continue
+		}
+		for j := range icon.IconClicks.IconClickFallbackImages.IconClickFallbackImage {
+			img := &icon.IconClicks.IconClickFallbackImages.IconClickFallbackImage[j]
+			if img.StaticResource != nil && s.url(fmt.Sprintf("%s.IconClicks.IconClickFallbackImages.IconClickFallbackImage[%d].StaticResource", p, j), img.StaticResource.URI) {
+				img.StaticResource = nil
+			}
+		}
+	}
+}
+
+func (s *safetyScan) resources(path string, h *HTMLResource, iframe **CDATAString, static **StaticResource) {
+	if h != nil {
+		if res, ok := s.markup(path+".HTMLResource", h.DecodedHTML()); ok {
+			h.SetHTML(res, h.XMLEncoded)
+		}
+	}
+	if *iframe != nil && s.url(path+".IFrameResource", (*iframe).CDATA) {
+		*iframe = nil
+	}
+	if *static != nil && s.url(path+".StaticResource", (*static).URI) {
+		*static = nil
+	}
+}
+
+func (s *safetyScan) adParameters(path string, a *AdParameters) {
+	if a != nil {
+		if res, ok := s.markup(path, a.DecodedParameters()); ok {
+			a.SetParameters(res, a.XMLEncoded)
+		}
+	}
+}
+
+// markup checks an HTML payload, returning it sanitized when it must be
+// replaced.
+func (s *safetyScan) markup(path, payload string) (string, bool) {
+	res, issues := s.policy.scanHTML(payload, s.strip)
+	for _, issue := range issues {
+		issue.Path = path
+		s.issues = append(s.issues, issue)
+	}
+	return res, s.strip && len(issues) > 0
+}
+
+// url checks the URL of a resource, returning whether it must be removed.
+func (s *safetyScan) url(path, uri string) bool {
+	var issue SafetyIssue
+	switch {
+	case isJavaScriptURL(uri):
+		if s.policy.AllowJavaScriptURLs {
+			return false
+		}
+		issue = SafetyIssue{Path: path, Kind: SafetyIssueJavaScriptURL, Detail: snippet(uri)}
+	case !isHTTPS(uri):
+		if s.policy.AllowInsecureResources {
+			return false
+		}
+		issue = SafetyIssue{Path: path, Kind: SafetyIssueInsecureResource, Detail: snippet(strings.TrimSpace(uri))}
+	default:
+		return false
+	}
+	s.issues = append(s.issues, issue)
+	return s.strip
+}
+
+func isHTTPS(uri string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(uri)), "https://")
+}
+
+// isJavaScriptURL reports whether uri uses the javascript: scheme, ignoring
+// the character references, whitespace and control characters browsers
+// ignore as well.
+func isJavaScriptURL(uri string) bool {
+	uri = strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return r
+	}, html.UnescapeString(uri))
+	return strings.HasPrefix(strings.ToLower(uri), "javascript:")
+}
+
+// refreshURL returns the URL of the content of a meta refresh, such as
+// "5; url=https://example.com".
+func refreshURL(content string) string {
+	i := strings.IndexByte(content, ';')
+	if i < 0 {
+		return ""
+	}
+	s := strings.TrimSpace(content[i+1:])
+	if len(s) > 4 && strings.EqualFold(s[:3], "url") {
+		if rest := strings.TrimLeft(s[3:], " \t\n\r\f"); strings.HasPrefix(rest, "=") {
+			s = strings.TrimSpace(rest[1:])
+		}
+	}
+	return strings.Trim(s, `"'`)
+}
+
+// snippet truncates s for an issue.
+func snippet(s string) string {
+	const max = 80
+	if len(s) > max {
+		return s[:max] + "..."
+	}
+	return s
+}
+
+// urlAttrs are the HTML attributes holding URLs.
+var urlAttrs = map[string]bool{
+	"href":       true,
+	"src":        true,
+	"action":     true,
+	"formaction": true,
+	"data":       true,
+	"background": true,
+	"poster":     true,
+	"xlink:href": true,
+}
+
+// animationAttrs are the attributes of the SVG animation elements holding
+// the values they set.
+var animationAttrs = map[string]bool{
+	"values": true,
+	"from":   true,
+	"to":     true,
+	"by":     true,
+}
+
+// scanHTML finds the constructs of markup rejected by the policy and, when
+// stripping, removes them. The markup is parsed as the content of a <body>
+// element, the way browsers parse it, and the sanitized tree rendered back:
+// markup without any rejected construct is kept as is. A tag left
+// unterminated at the end of the markup is rejected, since it would swallow
+// the markup following the snippet.
+func (p SafetyPolicy) scanHTML(markup string, strip bool) (string, []SafetyIssue) {
+	s := htmlScan{policy: p}
+	if tag, ok := unterminatedTag(markup); ok {
+		s.report(SafetyIssueUnterminatedTag, tag)
+	}
+	body := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(markup), body)
+	if err != nil {
+		s.report(SafetyIssueUnterminatedTag, markup)
+		return "", s.issues
+	}
+	var kept []*html.Node
+	for _, n := range nodes {
+		if !s.node(n) {
+			kept = append(kept, n)
+		}
+	}
+	if !strip || len(s.issues) == 0 {
+		return markup, s.issues
+	}
+	var b strings.Builder
+	for _, n := range kept {
+		html.Render(&b, n)
+	}
+	return b.String(), s.issues
+}
+
+// htmlScan walks a parsed HTML fragment.
+type htmlScan struct {
+	policy SafetyPolicy
+	issues []SafetyIssue
+}
+
+func (s *htmlScan) report(kind SafetyIssueKind, detail string) {
+	s.issues = append(s.issues, SafetyIssue{Kind: kind, Detail: snippet(strings.TrimSpace(detail))})
+}
+
+// node checks a node and its children, removing the rejected attributes and
+// children. It returns whether the node itself must be removed.
+func (s *htmlScan) node(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	name := strings.ToLower(n.Data)
+	switch name {
+	case "script":
+		_, src := htmlAttr(n, "src")
+		_, href := htmlAttr(n, "href")
+		if content := textContent(n); !src && !href && strings.TrimSpace(content) != "" && !s.policy.AllowInlineScripts {
+			s.report(SafetyIssueInlineScript, content)
+			return true
+		}
+	case "iframe":
+		// javascript: URLs are reported along with the other attributes
+		if src, ok := htmlAttr(n, "src"); ok && !isHTTPS(src) && !isJavaScriptURL(src) && !s.policy.AllowInsecureIFrames {
+			s.report(SafetyIssueInsecureIFrame, issueTag(n))
+			return true
+		}
+	}
+
+	var refresh, animation bool
+	switch name {
+	case "meta":
+		equiv, _ := htmlAttr(n, "http-equiv")
+		refresh = strings.EqualFold(strings.TrimSpace(equiv), "refresh")
+	case "animate", "set", "animatemotion", "animatetransform":
+		target, _ := htmlAttr(n, "attributename")
+		target = strings.ToLower(strings.TrimSpace(target))
+		animation = target == "href" || target == "xlink:href"
+	}
+	kept := n.Attr[:0:0]
+	for _, a := range n.Attr {
+		key := attrName(a)
+		switch {
+		case strings.HasPrefix(key, "on") && !s.policy.AllowInlineScripts:
+			s.report(SafetyIssueInlineScript, attrString(a))
+		case urlAttrs[key] && isJavaScriptURL(a.Val) && !s.policy.AllowJavaScriptURLs:
+			s.report(SafetyIssueJavaScriptURL, attrString(a))
+		case refresh && key == "content" && isJavaScriptURL(refreshURL(a.Val)) && !s.policy.AllowJavaScriptURLs:
+			s.report(SafetyIssueJavaScriptURL, attrString(a))
+		case animation && animationAttrs[key] && hasJavaScriptValue(a.Val) && !s.policy.AllowJavaScriptURLs:
+			s.report(SafetyIssueJavaScriptURL, attrString(a))
+		case key == "srcdoc":
+			// the document of the iframe, removed as a whole when any of its
+			// constructs is rejected
+			_, nested := s.policy.scanHTML(a.Val, false)
+			s.issues = append(s.issues, nested...)
+			if len(nested) == 0 {
+				kept = append(kept, a)
+			}
+		default:
+			kept = append(kept, a)
+		}
+	}
+	n.Attr = kept
+
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if s.node(c) {
+			n.RemoveChild(c)
+		}
+		c = next
+	}
+	return false
+}
+
+// unterminatedTag returns the tag left unterminated at the end of markup,
+// if any.
+func unterminatedTag(markup string) (string, bool) {
+	z := html.NewTokenizer(strings.NewReader(markup))
+	for z.Next() != html.ErrorToken {
+	}
+	if z.Err() != io.EOF {
+		return "", false
+	}
+	raw := string(z.Raw())
+	if len(raw) > 1 && raw[0] == '<' && (raw[1] == '/' || isASCIILetter(raw[1])) {
+		return raw, true
+	}
+	return "", false
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// attrName returns the lower cased name of an attribute, prefixed with its
+// namespace, e.g. xlink:href.
+func attrName(a html.Attribute) string {
+	if a.Namespace != "" {
+		return strings.ToLower(a.Namespace + ":" + a.Key)
+	}
+	return strings.ToLower(a.Key)
+}
+
+func htmlAttr(n *html.Node, name string) (string, bool) {
+	for _, a := range n.Attr {
+		if attrName(a) == name {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+func attrString(a html.Attribute) string {
+	return attrName(a) + `="` + a.Val + `"`
+}
+
+// issueTag formats the start tag of an element for an issue.
+func issueTag(n *html.Node) string {
+	var b strings.Builder
+	b.WriteString("<" + n.Data)
+	for _, a := range n.Attr {
+		b.WriteString(" " + attrString(a))
+	}
+	b.WriteString(">")
+	return b.String()
+}
+
+func textContent(n *html.Node) string {
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.TextNode {
+			b.WriteString(c.Data)
+		}
+	}
+	return b.String()
+}
+
+// hasJavaScriptValue reports whether any of the semicolon separated values
+// of an animation is a javascript: URL.
+func hasJavaScriptValue(values string) bool {
+	for _, v := range strings.Split(values, ";") {
+		if isJavaScriptURL(v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package vast
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafetyCheckHTML(t *testing.T) {
+	markup := `<div onclick="track()"><script>alert(1)</script><script src="https://cdn.example.com/ad.js"></script>` +
+		`<a href=" JaVa&#x53;cript:alert(1)">click</a><a href="https://example.com">ok</a>` +
+		`<iframe src="http://example.com/frame"><p>fallback</p></iframe><iframe src="//example.com/frame"></iframe></div>`
+
+	issues := SafetyPolicy{}.CheckHTML(markup)
+	assert.Equal(t, []SafetyIssue{
+		{Kind: SafetyIssueInlineScript, Detail: `onclick="track()"`},
+		{Kind: SafetyIssueInlineScript, Detail: `alert(1)`},
+		{Kind: SafetyIssueJavaScriptURL, Detail: `href=" JaVaScript:alert(1)"`},
+		{Kind: SafetyIssueInsecureIFrame, Detail: `<iframe src="http://example.com/frame">`},
+		{Kind: SafetyIssueInsecureIFrame, Detail: `<iframe src="//example.com/frame">`},
+	}, issues)
+	assert.Equal(t, `inline-script onclick="track()"`, issues[0].String())
+
+	assert.Empty(t, SafetyPolicy{AllowInlineScripts: true, AllowJavaScriptURLs: true, AllowInsecureIFrames: true}.CheckHTML(markup))
+}
+
+func TestSafetyCheckHTMLEmbedded(t *testing.T) {
+	for markup, want := range map[string][]SafetyIssue{
+		`<iframe srcdoc="<script>alert(1)</script>"></iframe>`: {
+			{Kind: SafetyIssueInlineScript, Detail: `alert(1)`},
+		},
+		`<iframe srcdoc="&lt;a href=&quot;javascript:x()&quot;&gt;"></iframe>`: {
+			{Kind: SafetyIssueJavaScriptURL, Detail: `href="javascript:x()"`},
+		},
+		`<iframe src="data:text/html,<script>alert(1)</script>"></iframe>`: {
+			{Kind: SafetyIssueInsecureIFrame, Detail: `<iframe src="data:text/html,<script>alert(1)</script>">`},
+		},
+		`<meta http-equiv=refresh content="0;url=javascript:alert(1)">`: {
+			{Kind: SafetyIssueJavaScriptURL, Detail: `content="0;url=javascript:alert(1)"`},
+		},
+		`<meta http-equiv="Refresh" content="5; URL='JavaScript:alert(1)'">`: {
+			{Kind: SafetyIssueJavaScriptURL, Detail: `content="5; URL='JavaScript:alert(1)'"`},
+		},
+		`<iframe srcdoc="<p>ad</p>" src="https://example.com/frame"></iframe>`: nil,
+		`<meta http-equiv="refresh" content="0;url=https://example.com">`:      nil,
+	} {
+		assert.Equal(t, want, SafetyPolicy{}.CheckHTML(markup), markup)
+	}
+
+	res, issues := SafetyPolicy{}.SanitizeHTML(`<iframe srcdoc="<script>alert(1)</script>" width=300></iframe>` +
+		`<meta http-equiv=refresh content="0;url=javascript:alert(1)">`)
+	assert.Equal(t, `<iframe width="300"></iframe><meta http-equiv="refresh"/>`, res)
+	assert.Len(t, issues, 2)
+	res, issues = SafetyPolicy{}.SanitizeHTML(`<iframe src="data:text/html,x"><p>fallback</p></iframe>ok`)
+	assert.Equal(t, `ok`, res)
+	assert.Len(t, issues, 1)
+}
+
+func TestSafetyHTMLParsing(t *testing.T) {
+	onerror := SafetyIssue{Kind: SafetyIssueInlineScript, Detail: `onerror="alert(1)"`}
+	for markup, tt := range map[string]struct {
+		issues    []SafetyIssue
+		sanitized string
+	}{
+		`<!--><img src=x onerror=alert(1)>-->`: {
+			[]SafetyIssue{onerror}, `<!----><img src="x"/>--&gt;`,
+		},
+		`<!---><img src=x onerror=alert(1)>-->`: {
+			[]SafetyIssue{onerror}, `<!----><img src="x"/>--&gt;`,
+		},
+		`<svg><style><img src=x onerror=alert(1)></style></svg>`: {
+			[]SafetyIssue{onerror}, `<svg><style><img src="x"/></style></svg>`,
+		},
+		`<math><style><img src=x onerror=alert(1)></style></math>`: {
+			[]SafetyIssue{onerror}, `<math><style><img src="x"/></style></math>`,
+		},
+		`<noscript><p title="</noscript><img src=x onerror=alert(1)>"></noscript>`: {
+			[]SafetyIssue{onerror}, `<noscript><p title="</noscript><img src="x"/>&#34;&gt;`,
+		},
+		`<p>ad</p><img src=x onerror=alert(1)//`: {
+			[]SafetyIssue{{Kind: SafetyIssueUnterminatedTag, Detail: `<img src=x onerror=alert(1)//`}}, `<p>ad</p>`,
+		},
+		`<svg><animate attributeName=href values=javascript:alert(1) /><set attributeName="xlink:href" to="JavaScript:alert(1)"/></svg>`: {
+			[]SafetyIssue{
+				{Kind: SafetyIssueJavaScriptURL, Detail: `values="javascript:alert(1)"`},
+				{Kind: SafetyIssueJavaScriptURL, Detail: `to="JavaScript:alert(1)"`},
+			},
+			`<svg><animate attributeName="href"></animate><set attributeName="xlink:href"></set></svg>`,
+		},
+	} {
+		assert.Equal(t, tt.issues, SafetyPolicy{}.CheckHTML(markup), markup)
+		res, _ := SafetyPolicy{}.SanitizeHTML(markup)
+		assert.Equal(t, tt.sanitized, res, markup)
+		assert.Empty(t, SafetyPolicy{}.CheckHTML(res), markup)
+	}
+}
+
+func TestSafetySanitizeHTML(t *testing.T) {
+	markup := `<div onclick="track()" class=ad><SCRIPT>alert("</div>")</SCRIPT>` +
+		`<a href='javascript:alert(1)' target=_blank>click</a>` +
+		`<img src="https://example.com/img.png" onerror=alert(1) />` +
+		`<iframe src="http://example.com/frame"><p>fallback</p></iframe><!-- <script>x()</script> -->text</div>`
+
+	res, issues := SafetyPolicy{}.SanitizeHTML(markup)
+	assert.Equal(t, `<div class="ad"><a target="_blank">click</a><img src="https://example.com/img.png"/><!-- <script>x()</script> -->text</div>`, res)
+	assert.Len(t, issues, 5)
+
+	res, issues = SafetyPolicy{AllowInlineScripts: true}.SanitizeHTML(markup)
+	assert.Equal(t, `<div onclick="track()" class="ad"><script>alert("</div>")</script>`+
+		`<a target="_blank">click</a><img src="https://example.com/img.png" onerror="alert(1)"/><!-- <script>x()</script> -->text</div>`, res)
+	assert.Len(t, issues, 2)
+
+	// untouched markup
+	markup = `<p>a < b & <b>c</b></p><br/>`
+	res, issues = SafetyPolicy{}.SanitizeHTML(markup)
+	assert.Equal(t, markup, res)
+	assert.Empty(t, issues)
+}
+
+func TestSafetyDocument(t *testing.T) {
+	v := &VAST{Ads: []Ad{{InLine: &InLine{Creatives: []Creative{{
+		Linear: &Linear{
+			AdParameters: &AdParameters{XMLEncoded: true, Parameters: `<script>init()</script><p>ad</p>`},
+			Icons: &Icons{Icon: []Icon{{
+				StaticResource: &StaticResource{URI: "http://example.com/icon.png"},
+				IconClicks: &IconClicks{IconClickFallbackImages: &IconClickFallbackImages{IconClickFallbackImage: []IconClickFallbackImage{
+					{StaticResource: &StaticResource{URI: "https://example.com/fallback.png"}},
+					{StaticResource: &StaticResource{URI: "http://example.com/fallback.png"}},
+				}}},
+			}}},
+		},
+	}, {
+		CompanionAds: &CompanionAds{Companions: []Companion{{
+			HTMLResource:   &HTMLResource{HTML: `<a href="javascript:void(0)">x</a>`},
+			IFrameResource: &CDATAString{" https://example.com/frame "},
+			StaticResource: &StaticResource{URI: "javascript:alert(1)"},
+		}, {
+			IFrameResource: &CDATAString{"http://example.com/frame"},
+		}}},
+	}}}}, {Wrapper: &Wrapper{Creatives: []CreativeWrapper{{
+		CompanionAds: &CompanionAdsWrapper{Companions: []CompanionWrapper{{
+			HTMLResource: &HTMLResource{HTML: `<iframe src="http://example.com"></iframe>`},
+		}}},
+	}}}}}}
+
+	want := []SafetyIssue{
+		{Path: "Ads[0].InLine.Creatives[0].Linear.AdParameters", Kind: SafetyIssueInlineScript, Detail: "init()"},
+		{Path: "Ads[0].InLine.Creatives[0].Linear.Icons.Icon[0].StaticResource", Kind: SafetyIssueInsecureResource, Detail: "http://example.com/icon.png"},
+		{Path: "Ads[0].InLine.Creatives[0].Linear.Icons.Icon[0].IconClicks.IconClickFallbackImages.IconClickFallbackImage[1].StaticResource", Kind: SafetyIssueInsecureResource, Detail: "http://example.com/fallback.png"},
+		{Path: "Ads[0].InLine.Creatives[1].CompanionAds.Companions[0].HTMLResource", Kind: SafetyIssueJavaScriptURL, Detail: `href="javascript:void(0)"`},
+		{Path: "Ads[0].InLine.Creatives[1].CompanionAds.Companions[0].StaticResource", Kind: SafetyIssueJavaScriptURL, Detail: "javascript:alert(1)"},
+		{Path: "Ads[0].InLine.Creatives[1].CompanionAds.Companions[1].IFrameResource", Kind: SafetyIssueInsecureResource, Detail: "http://example.com/frame"},
+		{Path: "Ads[1].Wrapper.Creatives[0].CompanionAds.Companions[0].HTMLResource", Kind: SafetyIssueInsecureIFrame, Detail: `<iframe src="http://example.com">`},
+	}
+	c := v.Clone()
+	assert.Equal(t, want, SafetyPolicy{}.Check(c))
+	assert.Equal(t, v, c, "checking modifies the document")
+
+	assert.Equal(t, want, SafetyPolicy{}.Sanitize(c))
+	linear := c.Ads[0].InLine.Creatives[0].Linear
+	assert.Equal(t, `<p>ad</p>`, linear.AdParameters.Parameters)
+	assert.True(t, linear.AdParameters.XMLEncoded)
+	assert.Nil(t, linear.Icons.Icon[0].StaticResource)
+	images := linear.Icons.Icon[0].IconClicks.IconClickFallbackImages.IconClickFallbackImage
+	assert.NotNil(t, images[0].StaticResource)
+	assert.Nil(t, images[1].StaticResource)
+	companions := c.Ads[0].InLine.Creatives[1].CompanionAds.Companions
+	assert.Equal(t, `<a>x</a>`, companions[0].HTMLResource.HTML)
+	assert.NotNil(t, companions[0].IFrameResource)
+	assert.Nil(t, companions[0].StaticResource)
+	assert.Nil(t, companions[1].IFrameResource)
+	assert.Equal(t, "", c.Ads[1].Wrapper.Creatives[0].CompanionAds.Companions[0].HTMLResource.HTML)
+
+	// insecure resources accepted
+	assert.Len(t, SafetyPolicy{AllowInsecureResources: true}.Check(v), 4)
+	assert.Empty(t, SafetyPolicy{}.Check(c))
+}
+
+func TestSafetySpotX(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/spotx_vpaid.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	var v VAST
+	if !assert.NoError(t, Unmarshal(b, &v)) {
+		return
+	}
+	assert.Empty(t, SafetyPolicy{}.Check(&v))
+	c := v.Clone()
+	assert.Empty(t, SafetyPolicy{}.Sanitize(c))
+	assert.Equal(t, &v, c)
+}
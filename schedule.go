@@ -0,0 +1,109 @@
+package vast
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// The events of the beacons of a schedule which are not tracking events.
+const (
+	// The impressions of the ad.
+	EventImpression EventType = "impression"
+	// The IconViewTracking of an icon, when it is displayed.
+	EventIconView EventType = "iconView"
+)
+
+// Beacon is a scheduled tracking event.
+type Beacon struct {
+	// The time of the event, from the start of the ad.
+	Offset time.Duration
+	// The wall clock time of the event.
+	Time  time.Time
+	Event EventType
+	// The URLs to request, the ones of the inline ad first.
+	URIs []string
+}
+
+// Schedule returns the beacons of a linear creative played from start for
+// duration, or its own duration when zero, in time order: the impressions,
+// the creativeView, start, quartiles and complete events, the progress events
+// at their offset and the IconViewTracking of the icons at their offset.
+//
+// The impressions are the ones of the inline ad and of the wrappers of its
+// chain, and wrappers the linear creatives of the wrappers, whose tracking
+// events and icons are scheduled along with the ones of the inline ad. The
+// beacons of the same event at the same time are merged.
+func Schedule(l *Linear, duration Duration, start time.Time, impressions []Impression, wrappers ...*LinearWrapper) []Beacon {
+	if duration == 0 {
+		duration = l.Duration
+	}
+	d := time.Duration(duration)
+	var beacons []Beacon
+	type key struct {
+		at    time.Duration
+		event EventType
+	}
+	index := map[key]int{}
+	add := func(at time.Duration, e EventType, uri string) {
+		uri = strings.TrimSpace(uri)
+		if uri == "" || at < 0 || at > d {
+			return
+		}
+		k := key{at, e}
+		if i, ok := index[k]; ok {
+			beacons[i].URIs = append(beacons[i].URIs, uri)
+			return
+		}
+		index[k] = len(beacons)
+		beacons = append(beacons, Beacon{Offset: at, Time: start.Add(at), Event: e, URIs: []string{uri}})
+	}
+
+	for _, imp := range impressions {
+		add(0, EventImpression, imp.URI)
+	}
+	trackings := append(TrackingEvents(nil), l.TrackingEvents...)
+	icons := []*Icons{l.Icons}
+	for _, w := range wrappers {
+		if w != nil {
+			trackings = append(trackings, w.TrackingEvents...)
+			icons = append(icons, w.Icons)
+		}
+	}
+	for _, e := range []struct {
+		event EventType
+		at    time.Duration
+	}{
+		{Event_type_creativeView, 0},
+		{Event_type_start, 0},
+		{Event_type_firstQuartile, d / 4},
+		{Event_type_midpoint, d / 2},
+		{Event_type_thirdQuartile, d * 3 / 4},
+		{Event_type_complete, d},
+	} {
+		for _, uri := range trackings.URIs(e.event) {
+			add(e.at, e.event, uri)
+		}
+	}
+	for _, t := range trackings.ByEvent(Event_type_progress) {
+		if t.Offset != nil {
+			add(time.Duration(t.Offset.Resolve(duration)), Event_type_progress, t.URI)
+		}
+	}
+	for _, is := range icons {
+		if is == nil {
+			continue
+		}
+		for _, icon := range is.Icon {
+			var at time.Duration
+			if icon.Offset != nil {
+				at = time.Duration(icon.Offset.Resolve(duration))
+			}
+			for _, uri := range icon.IconViewTrackings {
+				add(at, EventIconView, uri.CDATA)
+			}
+		}
+	}
+	sort.SliceStable(beacons, func(i, j int) bool { return beacons[i].Offset < beacons[j].Offset })
+	return beacons
+}
@@ -0,0 +1,81 @@
+package vast
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedule(t *testing.T) {
+	v, _, _, err := loadFixture("testdata/vast4_universal_ad_id.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	inline := v.Ads[0].InLine
+	l := inline.Creatives[0].Linear
+	five := Duration(5 * time.Second)
+	l.TrackingEvents = append(l.TrackingEvents,
+		Tracking{Event: Event_type_progress, Offset: &Offset{Duration: &five}, URI: "http://example.com/tracking/5s"},
+		Tracking{Event: Event_type_progress, Offset: &Offset{Percent: 0.1}, URI: "http://example.com/tracking/10pct"},
+		Tracking{Event: Event_type_progress, Offset: &Offset{Percent: 0.1}, URI: "http://example.com/tracking/10pct-2"},
+		Tracking{Event: Event_type_pause, URI: "http://example.com/tracking/pause"},
+	)
+	two := Duration(2 * time.Second)
+	l.Icons = &Icons{Icon: []Icon{{Offset: &Offset{Duration: &two}, IconViewTrackings: []CDATAString{{"http://example.com/icon/view"}}}}}
+	wrapper := &LinearWrapper{
+		TrackingEvents: TrackingEvents{
+			{Event: Event_type_start, URI: "http://wrapper.com/start"},
+			{Event: Event_type_complete, URI: " "},
+		},
+		Icons: &Icons{Icon: []Icon{{IconViewTrackings: []CDATAString{{"http://wrapper.com/icon/view"}}}}},
+	}
+	impressions := append(inline.Impressions, Impression{URI: "http://wrapper.com/impression"})
+	start := time.Date(2020, 5, 17, 20, 0, 0, 0, time.UTC)
+
+	beacons := Schedule(l, 0, start, impressions, wrapper, nil)
+	type beacon struct {
+		offset time.Duration
+		event  EventType
+		uris   []string
+	}
+	var got []beacon
+	for _, b := range beacons {
+		assert.Equal(t, start.Add(b.Offset), b.Time)
+		got = append(got, beacon{b.Offset, b.Event, b.URIs})
+	}
+	assert.Equal(t, []beacon{
+		{0, EventImpression, []string{"http://example.com/track/impression", "http://wrapper.com/impression"}},
+		{0, Event_type_start, []string{"http://example.com/tracking/start", "http://wrapper.com/start"}},
+		{0, EventIconView, []string{"http://wrapper.com/icon/view"}},
+		{1600 * time.Millisecond, Event_type_progress, []string{"http://example.com/tracking/10pct", "http://example.com/tracking/10pct-2"}},
+		{2 * time.Second, EventIconView, []string{"http://example.com/icon/view"}},
+		{4 * time.Second, Event_type_firstQuartile, []string{"http://example.com/tracking/firstQuartile"}},
+		{5 * time.Second, Event_type_progress, []string{"http://example.com/tracking/5s"}},
+		{8 * time.Second, Event_type_midpoint, []string{"http://example.com/tracking/midpoint"}},
+		{12 * time.Second, Event_type_thirdQuartile, []string{"http://example.com/tracking/thirdQuartile"}},
+		{16 * time.Second, Event_type_complete, []string{"http://example.com/tracking/complete"}},
+	}, got)
+
+	// the resolved duration overrides the one of the creative, the events
+	// past its end being dropped
+	beacons = Schedule(l, Duration(4*time.Second), start, nil)
+	if assert.NotEmpty(t, beacons) {
+		last := beacons[len(beacons)-1]
+		assert.Equal(t, 4*time.Second, last.Offset)
+		assert.Equal(t, Event_type_complete, last.Event)
+	}
+	for _, b := range beacons {
+		assert.NotEqual(t, []string{"http://example.com/tracking/5s"}, b.URIs)
+	}
+
+	// the beacons can be queued as JSON
+	b, err := json.Marshal(beacons[0])
+	if assert.NoError(t, err) {
+		var b2 Beacon
+		if assert.NoError(t, json.Unmarshal(b, &b2)) {
+			assert.Equal(t, beacons[0], b2)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package vast
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// errSkipTrackings is returned when a creative which cannot be skipped has
+// skip tracking events.
+var errSkipTrackings = errors.New("vast: skip tracking events on a creative which is not skippable")
+
+// Skippable reports whether the creative can be skipped, that is whether it
+// has a skip offset.
+func (l *Linear) Skippable() bool {
+	return l.SkipOffset != nil
+}
+
+// SkipTime returns the time from which the creative can be skipped,
+// percentages being resolved against its duration. It returns false when the
+// creative cannot be skipped.
+func (l *Linear) SkipTime() (Duration, bool) {
+	if l.SkipOffset == nil {
+		return 0, false
+	}
+	return l.SkipOffset.Resolve(l.Duration), true
+}
+
+// ValidateSkip checks that the skip tracking events are only defined on a
+// skippable creative, and that its skip offset is within its duration.
+func (l *Linear) ValidateSkip() error {
+	at, ok := l.SkipTime()
+	if !ok {
+		if len(l.TrackingEvents.ByEvent(Event_type_skip)) > 0 {
+			return errSkipTrackings
+		}
+		return nil
+	}
+	if at < 0 || l.SkipOffset.Duration == nil && l.SkipOffset.Percent > 1 {
+		return fmt.Errorf("vast: invalid skip offset %v", time.Duration(at))
+	}
+	if l.Duration > 0 && at > l.Duration {
+		return fmt.Errorf("vast: skip offset %v past the duration %v", time.Duration(at), time.Duration(l.Duration))
+	}
+	return nil
+}
+
+// SkipPolicy is a publisher policy on the skippability of linear creatives.
+type SkipPolicy struct {
+	// When not zero, the creatives longer than ForceSkippableAfter can be
+	// skipped from then on, or from their own skip offset when earlier.
+	ForceSkippableAfter time.Duration
+	// The creatives of the ads of pods cannot be skipped.
+	NeverSkippableInPods bool
+}
+
+// Apply returns a copy of the creative with the policy applied, inPod telling
+// whether its ad is part of a pod, that is has a sequence. The skip tracking
+// events of the creatives which cannot be skipped anymore are removed.
+func (p *SkipPolicy) Apply(l *Linear, inPod bool) *Linear {
+	c := cloneLinear(l)
+	if inPod && p.NeverSkippableInPods {
+		c.SkipOffset = nil
+		var trackings TrackingEvents
+		for _, t := range c.TrackingEvents {
+			if t.Event != Event_type_skip {
+				trackings = append(trackings, t)
+			}
+		}
+		c.TrackingEvents = trackings
+		return c
+	}
+	if force := Duration(p.ForceSkippableAfter); force > 0 && c.Duration > force {
+		if at, ok := c.SkipTime(); !ok || at > force {
+			c.SkipOffset = &Offset{Duration: &force}
+		}
+	}
+	return c
+}
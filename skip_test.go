@@ -0,0 +1,101 @@
+package vast
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinearSkipTime(t *testing.T) {
+	var l Linear
+	if !assert.NoError(t, xml.Unmarshal([]byte(`<Linear skipoffset="25%"><Duration>00:00:30</Duration></Linear>`), &l)) {
+		return
+	}
+	assert.True(t, l.Skippable())
+	at, ok := l.SkipTime()
+	assert.True(t, ok)
+	assert.Equal(t, Duration(7500*time.Millisecond), at)
+
+	l = Linear{}
+	if !assert.NoError(t, xml.Unmarshal([]byte(`<Linear skipoffset="00:00:05"><Duration>00:00:30</Duration></Linear>`), &l)) {
+		return
+	}
+	at, ok = l.SkipTime()
+	assert.True(t, ok)
+	assert.Equal(t, Duration(5*time.Second), at)
+
+	l = Linear{Duration: Duration(30 * time.Second)}
+	assert.False(t, l.Skippable())
+	_, ok = l.SkipTime()
+	assert.False(t, ok)
+}
+
+func TestLinearValidateSkip(t *testing.T) {
+	five := Duration(5 * time.Second)
+	skip := TrackingEvents{{Event: Event_type_skip, URI: "http://example.com/skip"}}
+	for name, tt := range map[string]struct {
+		linear Linear
+		valid  bool
+	}{
+		"not skippable":      {Linear{Duration: Duration(30 * time.Second)}, true},
+		"skippable":          {Linear{Duration: Duration(30 * time.Second), SkipOffset: &Offset{Duration: &five}, TrackingEvents: skip}, true},
+		"skip trackings":     {Linear{Duration: Duration(30 * time.Second), TrackingEvents: skip}, false},
+		"past duration":      {Linear{Duration: Duration(3 * time.Second), SkipOffset: &Offset{Duration: &five}}, false},
+		"percent":            {Linear{Duration: Duration(30 * time.Second), SkipOffset: &Offset{Percent: 0.5}}, true},
+		"percent over 100":   {Linear{Duration: Duration(30 * time.Second), SkipOffset: &Offset{Percent: 1.5}}, false},
+		"undefined duration": {Linear{SkipOffset: &Offset{Duration: &five}}, true},
+	} {
+		err := tt.linear.ValidateSkip()
+		if tt.valid {
+			assert.NoError(t, err, name)
+		} else {
+			assert.Error(t, err, name)
+		}
+	}
+}
+
+func TestSkipPolicyApply(t *testing.T) {
+	ten := Duration(10 * time.Second)
+	l := &Linear{
+		Duration:   Duration(30 * time.Second),
+		SkipOffset: &Offset{Duration: &ten},
+		TrackingEvents: TrackingEvents{
+			{Event: Event_type_start, URI: "http://example.com/start"},
+			{Event: Event_type_skip, URI: "http://example.com/skip"},
+		},
+	}
+	policy := &SkipPolicy{ForceSkippableAfter: 5 * time.Second, NeverSkippableInPods: true}
+
+	// the skip offset is lowered
+	c := policy.Apply(l, false)
+	at, ok := c.SkipTime()
+	assert.True(t, ok)
+	assert.Equal(t, Duration(5*time.Second), at)
+	assert.Equal(t, ten, *l.SkipOffset.Duration, "the original is unchanged")
+
+	// pods are not skippable
+	c = policy.Apply(l, true)
+	assert.False(t, c.Skippable())
+	assert.Equal(t, TrackingEvents{{Event: Event_type_start, URI: "http://example.com/start"}}, c.TrackingEvents)
+	assert.NoError(t, c.ValidateSkip())
+	assert.Len(t, l.TrackingEvents, 2)
+
+	// non skippable creatives become skippable
+	c = policy.Apply(&Linear{Duration: Duration(30 * time.Second)}, false)
+	at, ok = c.SkipTime()
+	assert.True(t, ok)
+	assert.Equal(t, Duration(5*time.Second), at)
+
+	// earlier skip offsets and short creatives are kept
+	two := Duration(2 * time.Second)
+	c = policy.Apply(&Linear{Duration: Duration(30 * time.Second), SkipOffset: &Offset{Duration: &two}}, false)
+	at, _ = c.SkipTime()
+	assert.Equal(t, two, at)
+	c = policy.Apply(&Linear{Duration: Duration(4 * time.Second)}, false)
+	assert.False(t, c.Skippable())
+
+	// the zero policy changes nothing
+	assert.Equal(t, l, (&SkipPolicy{}).Apply(l, true))
+}
@@ -0,0 +1,189 @@
+package vast
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// AdKind is the kind of an ad.
+type AdKind string
+
+const (
+	// An ad with an InLine element
+	AdKindInLine AdKind = "inline"
+	// An ad with a Wrapper element
+	AdKindWrapper AdKind = "wrapper"
+)
+
+// AdSummary is a compact description of an ad, for logs and reports.
+type AdSummary struct {
+	ID   string `json:",omitempty"`
+	Kind AdKind `json:",omitempty"`
+	// The ad systems of the chain, from the first wrapper to the ad, as
+	// "name" or "name/version".
+	AdSystems  []string `json:",omitempty"`
+	Advertiser string   `json:",omitempty"`
+	// The URI of the next ad of a wrapper.
+	AdTagURI string `json:",omitempty"`
+	// The universal ad IDs of the creatives, as "registry:id".
+	UniversalAdIDs []string `json:",omitempty"`
+	// The number of creatives and their kinds, in order.
+	Creatives     int            `json:",omitempty"`
+	CreativeKinds []CreativeKind `json:",omitempty"`
+	// The duration of the first linear creative.
+	Duration Duration `json:",omitempty"`
+	// The number of media files of the linear creatives and the dimensions
+	// of the largest one.
+	MediaFiles int  `json:",omitempty"`
+	MaxWidth   int  `json:",omitempty"`
+	MaxHeight  int  `json:",omitempty"`
+	Skippable  bool `json:",omitempty"`
+	VPAID      bool `json:",omitempty"`
+	// Whether the ad has OMID media files, or an extension holding an
+	// AdVerifications element with a Verification, as VAST 3 ads carry them.
+	OMID bool `json:",omitempty"`
+	// The number of tracking URLs by event, the impressions being counted
+	// as EventImpression.
+	Trackers map[EventType]int `json:",omitempty"`
+}
+
+// Summary returns the summary of the ad. The wrappers are the ads of the
+// chain which led to the ad, from the first one: their ad systems and their
+// trackers, which are requested along with the ones of the ad, are included.
+func (ad *Ad) Summary(wrappers ...*Ad) *AdSummary {
+	s := &AdSummary{ID: ad.ID, Trackers: map[EventType]int{}}
+	for _, w := range wrappers {
+		if w != nil && w.Wrapper != nil {
+			s.addWrapper(w.Wrapper)
+		}
+	}
+	switch {
+	case ad.InLine != nil:
+		s.Kind = AdKindInLine
+		s.addInLine(ad.InLine)
+	case ad.Wrapper != nil:
+		s.Kind = AdKindWrapper
+		s.AdTagURI = strings.TrimSpace(ad.Wrapper.VASTAdTagURI.CDATA)
+		s.addWrapper(ad.Wrapper)
+	}
+	if len(s.Trackers) == 0 {
+		s.Trackers = nil
+	}
+	return s
+}
+
+func (s *AdSummary) addAdSystem(system *AdSystem) {
+	if system == nil {
+		return
+	}
+	name := strings.TrimSpace(system.Name)
+	if v := strings.TrimSpace(system.Version); v != "" {
+		name += "/" + v
+	}
+	s.AdSystems = append(s.AdSystems, name)
+}
+
+func (s *AdSummary) addTrackers(ts TrackingEvents) {
+	for _, t := range ts {
+		s.Trackers[t.Event]++
+	}
+}
+
+func (s *AdSummary) addWrapper(w *Wrapper) {
+	s.addAdSystem(w.AdSystem)
+	s.Trackers[EventImpression] += len(w.Impressions)
+	for _, c := range w.Creatives {
+		if c.Linear != nil {
+			s.addTrackers(c.Linear.TrackingEvents)
+		}
+		if c.NonLinearAds != nil {
+			s.addTrackers(c.NonLinearAds.TrackingEvents)
+		}
+	}
+}
+
+func (s *AdSummary) addInLine(inline *InLine) {
+	s.addAdSystem(inline.AdSystem)
+	s.Advertiser = strings.TrimSpace(inline.Advertiser)
+	s.Trackers[EventImpression] += len(inline.Impressions)
+	s.Creatives = len(inline.Creatives)
+	for i := range inline.Creatives {
+		c := &inline.Creatives[i]
+		s.CreativeKinds = append(s.CreativeKinds, c.Analyze().Kind)
+		if id := c.UniversalAdID; id != nil {
+			s.UniversalAdIDs = append(s.UniversalAdIDs, strings.TrimSpace(id.IDRegistry)+":"+strings.TrimSpace(id.ID))
+		}
+		if c.Linear != nil {
+			s.addLinear(c, c.Linear)
+		}
+		if c.NonLinearAds != nil {
+			s.addTrackers(c.NonLinearAds.TrackingEvents)
+			for _, nl := range c.NonLinearAds.NonLinears {
+				switch nonLinearKind(&nl, c.APIFramework) {
+				case CreativeKindVPAIDJS, CreativeKindVPAIDFlash:
+					s.VPAID = true
+				}
+			}
+		}
+		if c.CompanionAds != nil {
+			for _, comp := range c.CompanionAds.Companions {
+				s.addTrackers(comp.TrackingEvents)
+			}
+		}
+	}
+	if inline.Extensions != nil {
+		for _, ext := range *inline.Extensions {
+			if hasVerification(ext) {
+				s.OMID = true
+			}
+		}
+	}
+}
+
+// hasVerification reports whether the extension holds a Verification
+// element, in an AdVerifications element or in an extension of type
+// AdVerifications.
+func hasVerification(ext Extension) bool {
+	d := xml.NewDecoder(strings.NewReader(ext.Data))
+	d.Strict = false
+	// the AdVerifications element, if any, is the parent of its children
+	parents := []bool{strings.EqualFold(ext.Type, "AdVerifications")}
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return false
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			inVerifications := parents[len(parents)-1]
+			if inVerifications && t.Name.Local == "Verification" {
+				return true
+			}
+			parents = append(parents, t.Name.Local == "AdVerifications")
+		case xml.EndElement:
+			if len(parents) > 1 {
+				parents = parents[:len(parents)-1]
+			}
+		}
+	}
+}
+
+func (s *AdSummary) addLinear(c *Creative, l *Linear) {
+	if s.Duration == 0 {
+		s.Duration = l.Duration
+	}
+	s.Skippable = s.Skippable || l.Skippable()
+	s.addTrackers(l.TrackingEvents)
+	s.MediaFiles += len(l.MediaFiles)
+	for _, mf := range l.MediaFiles {
+		if mf.Width*mf.Height > s.MaxWidth*s.MaxHeight {
+			s.MaxWidth, s.MaxHeight = mf.Width, mf.Height
+		}
+		switch mediaFileKind(mf.APIFramework, mf.Type, c.APIFramework) {
+		case CreativeKindVPAIDJS, CreativeKindVPAIDFlash:
+			s.VPAID = true
+		case CreativeKindOMIDOnly:
+			s.OMID = true
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package vast
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdSummaryInLine(t *testing.T) {
+	v, _, _, err := loadFixture("testdata/vast_inline_linear.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	s := v.Ads[0].Summary()
+	assert.Equal(t, "601364", s.ID)
+	assert.Equal(t, AdKindInLine, s.Kind)
+	assert.Equal(t, []string{"Acudeo Compatible/1.0"}, s.AdSystems)
+	assert.Equal(t, 2, s.Creatives)
+	assert.Equal(t, []CreativeKind{CreativeKindNativeVideo, CreativeKindCompanion}, s.CreativeKinds)
+	assert.Equal(t, Duration(30*time.Second), s.Duration)
+	assert.Equal(t, 1, s.MediaFiles)
+	assert.Equal(t, 400, s.MaxWidth)
+	assert.Equal(t, 300, s.MaxHeight)
+	assert.False(t, s.Skippable)
+	assert.False(t, s.VPAID)
+	assert.False(t, s.OMID)
+	assert.Equal(t, 2, s.Trackers[EventImpression])
+	assert.Equal(t, 2, s.Trackers[Event_type_creativeView])
+	assert.Equal(t, 1, s.Trackers[Event_type_complete])
+}
+
+func TestAdSummaryChain(t *testing.T) {
+	w, _, _, err := loadFixture("testdata/vast_wrapper_linear_1.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	v, _, _, err := loadFixture("testdata/vast_inline_linear.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	ws := w.Ads[0].Summary()
+	assert.Equal(t, AdKindWrapper, ws.Kind)
+	assert.NotEmpty(t, ws.AdTagURI)
+	assert.Zero(t, ws.Creatives)
+
+	s := v.Ads[0].Summary(&w.Ads[0])
+	assert.Equal(t, []string{"Acudeo Compatible", "Acudeo Compatible/1.0"}, s.AdSystems)
+	assert.Equal(t, 3, s.Trackers[EventImpression])
+	assert.Equal(t, ws.Trackers[Event_type_start]+1, s.Trackers[Event_type_start])
+	assert.Empty(t, s.AdTagURI)
+}
+
+func TestAdSummaryVPAID(t *testing.T) {
+	v, _, _, err := loadFixture("testdata/spotx_vpaid.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	s := v.Ads[0].Summary()
+	assert.True(t, s.VPAID)
+	assert.Equal(t, []CreativeKind{CreativeKindVPAIDJS, CreativeKindCompanion}, s.CreativeKinds)
+}
+
+func TestAdSummaryUniversalAdID(t *testing.T) {
+	v, _, _, err := loadFixture("testdata/vast4_universal_ad_id.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	s := v.Ads[0].Summary()
+	assert.Equal(t, []string{"Ad-ID:8465"}, s.UniversalAdIDs)
+}
+
+func TestAdSummaryOMID(t *testing.T) {
+	for data, omid := range map[string]bool{
+		`<AdVerifications><Verification vendor="v"><JavaScriptResource><![CDATA[https://example.com/omid.js]]></JavaScriptResource></Verification></AdVerifications>`: true,
+		`<Other><AdVerifications><Verification/></AdVerifications></Other>`:                                                                                           true,
+		`<AdVerifications></AdVerifications>`:                            false,
+		`<!-- <AdVerifications><Verification/></AdVerifications> -->`:    false,
+		`<![CDATA[<AdVerifications><Verification/></AdVerifications>]]>`: false,
+		`<Verification/>`: false,
+	} {
+		ad := &Ad{InLine: &InLine{Extensions: &[]Extension{{Data: data}}}}
+		assert.Equal(t, omid, ad.Summary().OMID, data)
+	}
+	ad := &Ad{InLine: &InLine{Extensions: &[]Extension{{Type: "AdVerifications", Data: `<Verification vendor="v"/>`}}}}
+	assert.True(t, ad.Summary().OMID)
+	ad = &Ad{InLine: &InLine{Extensions: &[]Extension{{Type: "AdVerifications"}}}}
+	assert.False(t, ad.Summary().OMID)
+}
+
+func TestAdSummaryJSON(t *testing.T) {
+	s := &AdSummary{
+		ID:        "1",
+		Kind:      AdKindInLine,
+		Duration:  Duration(15 * time.Second),
+		Skippable: true,
+		Trackers:  map[EventType]int{EventImpression: 2},
+	}
+	b, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"ID":"1","Kind":"inline","Duration":"00:00:15","Skippable":true,"Trackers":{"impression":2}}`, string(b))
+}
@@ -1,20 +1,18 @@
 // Package vast implements IAB VAST 3.0 specification http://www.iab.net/media/file/VASTv3.0.pdf
 package vast
 
-import "encoding/xml"
-
 // VAST is the root <VAST> tag
 type VAST struct {
 	// The version of the VAST spec (should be either "2.0" or "3.0")
 	Version string `xml:"version,attr" json:",omitempty"`
 	// XML namespace. Most likely 'http://www.iab.com/VAST'
-	XMLNS string `xml:"xmlns,attr,omitempty" json:"xmlns,omitempty"`
+	XMLNS string `xml:"xmlns,attr,omitempty" json:",omitempty"`
 	// One or more Ad elements. Advertisers and video content publishers may
 	// associate an <Ad> element with a line item video ad defined in contract
 	// documentation, usually an insertion order. These line item ads typically
 	// specify the creative to display, price, delivery schedule, targeting,
 	// and so on.
-	Ads []Ad `xml:"Ad,omitempty" json:"Ad,omitempty"`
+	Ads []Ad `xml:"Ad,omitempty" json:",omitempty"`
 	// Contains a URI to a tracking resource that the video player should request
 	// upon receiving a “no ad” response
 	Errors []CDATAString `xml:"Error,omitempty" json:",omitempty"`
@@ -39,9 +37,9 @@ type Ad struct {
 	AdType string `xml:"adType,attr,omitempty" json:",omitempty"`
 }
 
-// CDATAString ...
+// CDATAString is a text element, written as a CDATA section.
 type CDATAString struct {
-	CDATA string `xml:",cdata" json:"Data"`
+	CDATA string `xml:",cdata"`
 }
 
 // InLine is a vast <InLine> ad element containing actual ad definition
@@ -54,7 +52,7 @@ type InLine struct {
 	AdSystem *AdSystem
 	// A URI representing an error-tracking pixel; this element can occur multiple
 	// times.
-	Errors []CDATAString `xml:"Error,omitempty" json:"Error,omitempty"`
+	Errors []CDATAString `xml:"Error,omitempty" json:",omitempty"`
 	// XML node for custom extensions, as defined by the ad server. When used, a
 	// custom element should be nested under <Extensions> to help separate custom
 	// XML elements from VAST elements. The following example includes a custom
@@ -62,7 +60,7 @@ type InLine struct {
 	Extensions *[]Extension `xml:"Extensions>Extension,omitempty" json:",omitempty"`
 	// One or more URIs that directs the video player to a tracking resource file that the
 	// video player should request when the first frame of the ad is displayed
-	Impressions []Impression `xml:"Impression"`
+	Impressions []Impression `xml:"Impression" json:",omitempty"`
 	// Provides a value that represents a price that can be used by real-time bidding
 	// (RTB) systems. VAST is not designed to handle RTB since other methods exist,
 	// but this element is offered for custom solutions if needed.
@@ -79,8 +77,11 @@ type InLine struct {
 	// to interpret values provided within this element. As with any optional
 	// elements, the video player is not required to support it.
 	Advertiser string `xml:",omitempty" json:",omitempty"`
+	// VAST 4.1: the categories of the advertisement content, used to block
+	// the ads of sensitive categories.
+	Categories []Category `xml:"Category,omitempty" json:",omitempty"`
 	// The container for one or more <Creative> elements
-	Creatives []Creative `xml:"Creatives>Creative"`
+	Creatives []Creative `xml:"Creatives>Creative" json:",omitempty"`
 	// A string value that provides a longer description of the ad.
 	Description *CDATAString `xml:",omitempty" json:",omitempty"`
 	// A URI to a survey vendor that could be the survey, a tracking pixel,
@@ -90,6 +91,10 @@ type InLine struct {
 	// Surveys can be dynamically inserted into the VAST response as long as
 	// cross-domain issues are avoided.
 	Survey *CDATAString `xml:",omitempty" json:",omitempty"`
+	// VAST 4.1: the number of seconds in which the ad is valid for execution.
+	// When the ad is requested ahead of time, this is also how long the
+	// response may be cached before it must be requested again.
+	Expires int `xml:",omitempty" json:",omitempty"`
 }
 
 // Impression is a URI that directs the video player to a tracking resource file that
@@ -104,7 +109,7 @@ type Impression struct {
 // exist,  but this element is offered for custom solutions if needed.
 type Pricing struct {
 	// Identifies the pricing model as one of "cpm", "cpc", "cpe" or "cpv".
-	Model string `xml:"model,attr"`
+	Model PricingModel `xml:"model,attr"`
 	// The 3 letter ISO-4217 currency symbol that identifies the currency of
 	// the value provided
 	Currency string `xml:"currency,attr"`
@@ -115,6 +120,14 @@ type Pricing struct {
 	Value string `xml:",cdata"`
 }
 
+// Category is a category code of the advertisement content, from the taxonomy
+// identified by its authority, such as the IAB Content Taxonomy.
+type Category struct {
+	// The URL of the organization defining the taxonomy
+	Authority string `xml:"authority,attr,omitempty" json:",omitempty"`
+	Code      string `xml:",cdata"`
+}
+
 // Wrapper element contains a URI reference to a vendor ad server (often called
 // a third party ad server). The destination ad server either provides the ad
 // files within a VAST <InLine> ad element or may provide a secondary Wrapper
@@ -123,10 +136,10 @@ type Pricing struct {
 // the ad.
 type Wrapper struct {
 	// The name of the ad server that returned the ad
-	AdSystem *AdSystem
+	AdSystem *AdSystem `json:",omitempty"`
 	// A URI representing an error-tracking pixel; this element can occur multiple
 	// times.
-	Errors []CDATAString `xml:"Error,omitempty" json:"Error,omitempty"`
+	Errors []CDATAString `xml:"Error,omitempty" json:",omitempty"`
 	// XML node for custom extensions, as defined by the ad server. When used, a
 	// custom element should be nested under <Extensions> to help separate custom
 	// XML elements from VAST elements. The following example includes a custom
@@ -134,10 +147,13 @@ type Wrapper struct {
 	Extensions []Extension `xml:"Extensions>Extension,omitempty" json:",omitempty"`
 	// One or more URIs that directs the video player to a tracking resource file that the
 	// video player should request when the first frame of the ad is displayed
-	Impressions []Impression `xml:"Impression"`
+	Impressions []Impression `xml:"Impression" json:",omitempty"`
+	// The price of the ad, only the one of the first wrapper of a chain need
+	// be considered.
+	Pricing *Pricing `xml:",omitempty" json:",omitempty"`
 	// URL of ad tag of downstream Secondary Ad Server
 	// The container for one or more <Creative> elements
-	Creatives []CreativeWrapper `xml:"Creatives>Creative"`
+	Creatives []CreativeWrapper `xml:"Creatives>Creative" json:",omitempty"`
 	VASTAdTagURI CDATAString
 	FallbackOnNoAd           *bool `xml:"fallbackOnNoAd,attr,omitempty" json:",omitempty"`
 	AllowMultipleAds         *bool `xml:"allowMultipleAds,attr,omitempty" json:",omitempty"`
@@ -146,8 +162,8 @@ type Wrapper struct {
 
 // AdSystem contains information about the system that returned the ad
 type AdSystem struct {
-	Version string `xml:"version,attr,omitempty" json:"Version,omitempty"`
-	Name    string `xml:",cdata" json:"Data"`
+	Version string `xml:"version,attr,omitempty" json:",omitempty"`
+	Name    string `xml:",cdata"`
 }
 
 // Creative is a file that is part of a VAST ad.
@@ -187,13 +203,13 @@ type CompanionAds struct {
 	// Provides information about which companion creative to display.
 	// All means that the player must attempt to display all. Any means the player
 	// must attempt to play at least one. None means all companions are optional
-	Required   string      `xml:"required,attr,omitempty" json:",omitempty"`
-	Companions []Companion `xml:"Companion,omitempty" json:",omitempty"`
+	Required   CompanionsRequired `xml:"required,attr,omitempty" json:",omitempty"`
+	Companions []Companion         `xml:"Companion,omitempty" json:",omitempty"`
 }
 
 // NonLinearAds contains non linear creatives
 type NonLinearAds struct {
-	TrackingEvents []Tracking `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
+	TrackingEvents TrackingEvents `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
 	// Non linear creatives
 	NonLinears []NonLinear `xml:"NonLinear,omitempty" json:",omitempty"`
 }
@@ -219,13 +235,13 @@ type CompanionAdsWrapper struct {
 	// Provides information about which companion creative to display.
 	// All means that the player must attempt to display all. Any means the player
 	// must attempt to play at least one. None means all companions are optional
-	Required   string             `xml:"required,attr,omitempty" json:",omitempty"`
-	Companions []CompanionWrapper `xml:"Companion,omitempty" json:",omitempty"`
+	Required   CompanionsRequired `xml:"required,attr,omitempty" json:",omitempty"`
+	Companions []CompanionWrapper  `xml:"Companion,omitempty" json:",omitempty"`
 }
 
 // NonLinearAdsWrapper contains non linear creatives in a wrapper
 type NonLinearAdsWrapper struct {
-	TrackingEvents []Tracking `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
+	TrackingEvents TrackingEvents `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
 	// Non linear creatives
 	NonLinears []NonLinearWrapper `xml:"NonLinear,omitempty" json:",omitempty"`
 }
@@ -246,20 +262,20 @@ type Linear struct {
 	// indicates when the skip control should be provided after the creative
 	// begins playing.
 	SkipOffset *Offset `xml:"skipoffset,attr,omitempty" json:",omitempty"`
-	Icons          *Icons        `json:",omitempty"`
-	TrackingEvents []Tracking    `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
-	AdParameters   *AdParameters `xml:",omitempty" json:",omitempty"`
+	Icons          *Icons         `json:",omitempty"`
+	TrackingEvents TrackingEvents `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
+	AdParameters   *AdParameters  `xml:",omitempty" json:",omitempty"`
 	// Duration in standard time format, hh:mm:ss
 	Duration       Duration		 `xml:"Duration,omitempty" json:",omitempty"`
-	MediaFiles     []MediaFile   `xml:"MediaFiles>MediaFile,omitempty" json:",omitempty"`
-	VideoClicks    *VideoClicks  `xml:",omitempty" json:",omitempty"`
+	MediaFiles     []MediaFile    `xml:"MediaFiles>MediaFile,omitempty" json:",omitempty"`
+	VideoClicks    *VideoClicks   `xml:",omitempty" json:",omitempty"`
 }
 
 // LinearWrapper defines a wrapped linear creative
 type LinearWrapper struct {
-	Icons          *Icons       `json:",omitempty"`
-	TrackingEvents []Tracking   `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
-	VideoClicks    *VideoClicks `xml:",omitempty" json:",omitempty"`
+	Icons          *Icons         `json:",omitempty"`
+	TrackingEvents TrackingEvents `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
+	VideoClicks    *VideoClicks   `xml:",omitempty" json:",omitempty"`
 }
 
 // Companion defines a companion ad
@@ -282,6 +298,13 @@ type Companion struct {
 	APIFramework string `xml:"apiFramework,attr,omitempty" json:",omitempty"`
 	// Used to match companion creative to publisher placement areas on the page.
 	AdSlotID string `xml:"adSlotId,attr,omitempty" json:",omitempty"`
+	// VAST 4.1: the pixel ratio for which the companion creative is intended.
+	// Defaults to 1 when absent.
+	PxRatio float64 `xml:"pxratio,attr,omitempty" json:",omitempty"`
+	// VAST 4.1: how the companion should be rendered: along with the ad
+	// (default), once the ad is over (end-card) or along with the ad, in
+	// place of the content (concurrent).
+	RenderingMode RenderingMode `xml:"renderingMode,attr,omitempty" json:",omitempty"`
 	// HTML to display the companion element
 	HTMLResource *HTMLResource `xml:",omitempty" json:",omitempty"`
 	// URL source for an IFrame to display the companion element
@@ -299,7 +322,10 @@ type Companion struct {
 	CompanionClickTrackings []CompanionClickTracking `xml:"CompanionClickTracking,omitempty" json:",omitempty"`
 	// The creativeView should always be requested when present. For Companions
 	// creativeView is the only supported event.
-	TrackingEvents []Tracking `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
+	TrackingEvents TrackingEvents `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
+	// VAST 4.1: custom XML used to execute the companion, see
+	// Creative.CreativeExtensions.
+	CreativeExtensions *[]Extension `xml:"CreativeExtensions>CreativeExtension,omitempty" json:",omitempty"`
 }
 
 // CompanionWrapper defines a companion ad in a wrapper
@@ -307,30 +333,35 @@ type CompanionWrapper struct {
 	// Optional identifier
 	ID string `xml:"id,attr,omitempty" json:",omitempty"`
 	// Pixel dimensions of companion slot.
-	Width int `xml:"width,attr"`
+	Width int `xml:"width,attr,omitempty" json:",omitempty"`
 	// Pixel dimensions of companion slot.
-	Height int `xml:"height,attr"`
+	Height int `xml:"height,attr,omitempty" json:",omitempty"`
 	// Pixel dimensions of the companion asset.
-	AssetWidth int `xml:"assetWidth,attr"`
+	AssetWidth int `xml:"assetWidth,attr,omitempty" json:",omitempty"`
 	// Pixel dimensions of the companion asset.
-	AssetHeight int `xml:"assetHeight,attr"`
+	AssetHeight int `xml:"assetHeight,attr,omitempty" json:",omitempty"`
 	// Pixel dimensions of expanding companion ad when in expanded state.
-	ExpandedWidth int `xml:"expandedWidth,attr"`
+	ExpandedWidth int `xml:"expandedWidth,attr,omitempty" json:",omitempty"`
 	// Pixel dimensions of expanding companion ad when in expanded state.
-	ExpandedHeight int `xml:"expandedHeight,attr"`
+	ExpandedHeight int `xml:"expandedHeight,attr,omitempty" json:",omitempty"`
 	// The apiFramework defines the method to use for communication with the companion.
 	APIFramework string `xml:"apiFramework,attr,omitempty" json:",omitempty"`
 	// Used to match companion creative to publisher placement areas on the page.
 	AdSlotID string `xml:"adSlotId,attr,omitempty" json:",omitempty"`
+	// VAST 4.1: the pixel ratio for which the companion creative is intended.
+	// Defaults to 1 when absent.
+	PxRatio float64 `xml:"pxratio,attr,omitempty" json:",omitempty"`
+	// VAST 4.1: how the companion should be rendered, see Companion.RenderingMode.
+	RenderingMode RenderingMode `xml:"renderingMode,attr,omitempty" json:",omitempty"`
 	// URL to open as destination page when user clicks on the the companion banner ad.
 	CompanionClickThrough *CDATAString `xml:",omitempty" json:",omitempty"`
 	// URLs to ping when user clicks on the the companion banner ad.
-	CompanionClickTracking []CDATAString `xml:",omitempty" json:",omitempty"`
+	CompanionClickTracking []CompanionClickTracking `xml:",omitempty" json:",omitempty"`
 	// Alt text to be displayed when companion is rendered in HTML environment.
 	AltText string `xml:",omitempty" json:",omitempty"`
 	// The creativeView should always be requested when present. For Companions
 	// creativeView is the only supported event.
-	TrackingEvents []Tracking `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
+	TrackingEvents TrackingEvents `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
 	// Data to be passed into the companion ads. The apiFramework defines the method
 	// to use for communication (e.g. “FlashVar”)
 	AdParameters *AdParameters `xml:",omitempty" json:",omitempty"`
@@ -340,6 +371,9 @@ type CompanionWrapper struct {
 	IFrameResource *CDATAString `xml:",omitempty" json:",omitempty"`
 	// HTML to display the companion element
 	HTMLResource *HTMLResource `xml:",omitempty" json:",omitempty"`
+	// VAST 4.1: custom XML used to execute the companion, see
+	// Creative.CreativeExtensions.
+	CreativeExtensions *[]Extension `xml:"CreativeExtensions>CreativeExtension,omitempty" json:",omitempty"`
 }
 
 // NonLinear defines a non linear ad
@@ -399,20 +433,20 @@ type NonLinearWrapper struct {
 	// The apiFramework defines the method to use for communication with the nonlinear element.
 	APIFramework string `xml:"apiFramework,attr,omitempty" json:",omitempty"`
 	// The creativeView should always be requested when present.
-	TrackingEvents []Tracking `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
+	TrackingEvents TrackingEvents `xml:"TrackingEvents>Tracking,omitempty" json:",omitempty"`
 	// URLs to ping when user clicks on the the non-linear ad.
 	NonLinearClickTracking []CDATAString `xml:",omitempty" json:",omitempty"`
 }
 
+// Icons contains the icons of a linear creative
 type Icons struct {
-	XMLName xml.Name `xml:"Icons,omitempty" json:",omitempty"`
-	Icon    []Icon   `xml:"Icon,omitempty" json:",omitempty"`
+	Icon []Icon `xml:"Icon,omitempty" json:",omitempty"`
 }
 
 // Icon represents advertising industry initiatives like AdChoices.
 type Icon struct {
 	// Identifies the industry initiative that the icon supports.
-	Program string `xml:"program,attr"`
+	Program string `xml:"program,attr,omitempty" json:",omitempty"`
 	// Pixel dimensions of icon.
 	Width int `xml:"width,attr"`
 	// Pixel dimensions of icon.
@@ -424,23 +458,65 @@ type Icon struct {
 	// Must match ([0-9]*|top|bottom)
 	YPosition string `xml:"yPosition,attr"`
 	// Start time at which the player should display the icon. Expressed in standard time format hh:mm:ss.
-	Offset Offset `xml:"offset,attr"`
+	Offset *Offset `xml:"offset,attr,omitempty" json:",omitempty"`
 	// duration for which the player must display the icon. Expressed in standard time format hh:mm:ss.
-	Duration Duration `xml:"duration,attr"`
+	Duration *Duration `xml:"duration,attr,omitempty" json:",omitempty"`
 	// The apiFramework defines the method to use for communication with the icon element
 	APIFramework string `xml:"apiFramework,attr,omitempty" json:",omitempty"`
+	// VAST 4.1: the pixel ratio for which the icon creative is intended. The
+	// pixel ratio is the ratio of physical pixels on the device to the device
+	// independent pixels. Defaults to 1 when absent.
+	PxRatio float64 `xml:"pxratio,attr,omitempty" json:",omitempty"`
+	// VAST 4.2: alternative text for the image, for screen readers.
+	AltText string `xml:"altText,attr,omitempty" json:",omitempty"`
+	// VAST 4.2: text displayed when the user hovers over the icon.
+	HoverText string `xml:"hoverText,attr,omitempty" json:",omitempty"`
 	// HTML to display the companion element
 	HTMLResource *HTMLResource `xml:",omitempty" json:",omitempty"`
 	// URL source for an IFrame to display the companion element
 	IFrameResource *CDATAString `xml:",omitempty" json:",omitempty"`
 	// URL to a static file, such as an image or SWF file
 	StaticResource *StaticResource `xml:",omitempty" json:",omitempty"`
+	// The click-through, click trackers and click fallback images of the icon.
+	IconClicks *IconClicks `xml:",omitempty" json:",omitempty"`
+	// URIs for the tracking resource files to be called when the icon creative is displayed.
+	IconViewTrackings []CDATAString `xml:"IconViewTracking,omitempty" json:",omitempty"`
+}
+
+// IconClicks contains the types of icon clicks
+type IconClicks struct {
 	// URL to open as destination page when user clicks on the icon.
-	IconClickThrough *CDATAString `xml:"IconClicks>IconClickThrough,omitempty" json:",omitempty"`
+	IconClickThrough *CDATAString `xml:",omitempty" json:",omitempty"`
 	// URLs to ping when user clicks on the the icon.
-	IconClickTrackings []CDATAString `xml:"IconClicks>IconClickTracking,omitempty" json:",omitempty"`
-	// A URI for the tracking resource file to be called when the icon creative is displayed.
-	IconViewTracking *CDATAString `xml:"IconViewTracking,omitempty" json:",omitempty"`
+	IconClickTrackings []IconClickTracking `xml:"IconClickTracking,omitempty" json:",omitempty"`
+	// VAST 4.1: images to display when the user clicks on the icon and the
+	// device cannot open the IconClickThrough in a browser, as on most CTV.
+	IconClickFallbackImages *IconClickFallbackImages `xml:",omitempty" json:",omitempty"`
+}
+
+// IconClickFallbackImages contains the click fallback images of an icon
+type IconClickFallbackImages struct {
+	IconClickFallbackImage []IconClickFallbackImage `xml:",omitempty" json:",omitempty"`
+}
+
+// IconClickTracking element is used to track the click on an icon
+type IconClickTracking struct {
+	// An id provided by the ad server to track the click in reports.
+	ID  string `xml:"id,attr,omitempty" json:",omitempty"`
+	URI string `xml:",cdata"`
+}
+
+// IconClickFallbackImage is an image displayed when the user clicks on an
+// icon on a device which cannot open a browser.
+type IconClickFallbackImage struct {
+	// Pixel dimensions of the image.
+	Width int `xml:"width,attr,omitempty" json:",omitempty"`
+	// Pixel dimensions of the image.
+	Height int `xml:"height,attr,omitempty" json:",omitempty"`
+	// Alt text of the image, for screen readers.
+	AltText string `xml:",omitempty" json:",omitempty"`
+	// URL of the image.
+	StaticResource *StaticResource `xml:",omitempty" json:",omitempty"`
 }
 
 // Tracking defines an event tracking URL
@@ -450,8 +526,9 @@ type Tracking struct {
 	//
 	// Possible values are creativeView, start, firstQuartile, midpoint, thirdQuartile,
 	// complete, mute, unmute, pause, rewind, resume, fullscreen, exitFullscreen, expand,
-	// collapse, acceptInvitation, close, skip, progress.
-	Event string `xml:"event,attr"`
+	// collapse, acceptInvitation, close, skip, progress, see EventTypes for the
+	// full list.
+	Event EventType `xml:"event,attr"`
 	// The time during the video at which this url should be pinged. Must be present for
 	// progress event. Must match (\d{2}:[0-5]\d:[0-5]\d(\.\d\d\d)?|1?\d?\d(\.?\d)*%)
 	Offset *Offset `xml:"offset,attr,omitempty" json:",omitempty"`
@@ -3,7 +3,6 @@ package vast
 import (
 	"encoding/json"
 	"encoding/xml"
-	"github.com/pquerna/ffjson/ffjson"
 	"io/ioutil"
 	"os"
 	"reflect"
@@ -72,7 +71,7 @@ func TestQuickStart(t *testing.T) {
 		},
 	}
 
-	want := []byte(`{"Version":"3.0","Ad":[{"InLine":{"AdSystem":{"Data":"DSP"},"Extensions":[{"Type":"ClassName","Data":"AdsVideoView"},{"Type":"ExtURL","Data":"http://xxxxxxxx"}],"Impressions":[{"ID":"11111","URI":"http://impressionv1.track.com"},{"ID":"11112","URI":"http://impressionv2.track.com"}],"AdTitle":{"Data":"adTitle"},"Creatives":[{"ID":"987","Linear":{"SkipOffset":"00:00:05","TrackingEvents":[{"Event":"start","URI":"http://track.xxx.com/q/start?xx"},{"Event":"firstQuartile","URI":"http://track.xxx.com/q/firstQuartile?xx"},{"Event":"midpoint","URI":"http://track.xxx.com/q/midpoint?xx"},{"Event":"thirdQuartile","URI":"http://track.xxx.com/q/thirdQuartile?xx"},{"Event":"complete","URI":"http://track.xxx.com/q/complete?xx"}],"Duration":"00:00:15","MediaFiles":[{"Delivery":"progressive","Type":"video/mp4","Width":1024,"Height":576,"URI":"http://mp4.res.xxx.com/new_video/2020/01/14/1485/335928CBA9D02E95E63ED9F4D45DF6DF_20200114_1_1_1051.mp4"}]}}]},"ID":"123"}],"Mute":true}`)
+	want := []byte(`{"Version":"3.0","Ads":[{"InLine":{"AdSystem":{"Name":"DSP"},"Extensions":[{"Type":"ClassName","Data":"AdsVideoView"},{"Type":"ExtURL","Data":"http://xxxxxxxx"}],"Impressions":[{"ID":"11111","URI":"http://impressionv1.track.com"},{"ID":"11112","URI":"http://impressionv2.track.com"}],"AdTitle":"adTitle","Creatives":[{"ID":"987","Linear":{"SkipOffset":"00:00:05","TrackingEvents":[{"Event":"start","URI":"http://track.xxx.com/q/start?xx"},{"Event":"firstQuartile","URI":"http://track.xxx.com/q/firstQuartile?xx"},{"Event":"midpoint","URI":"http://track.xxx.com/q/midpoint?xx"},{"Event":"thirdQuartile","URI":"http://track.xxx.com/q/thirdQuartile?xx"},{"Event":"complete","URI":"http://track.xxx.com/q/complete?xx"}],"Duration":"00:00:15","MediaFiles":[{"Delivery":"progressive","Type":"video/mp4","Width":1024,"Height":576,"URI":"http://mp4.res.xxx.com/new_video/2020/01/14/1485/335928CBA9D02E95E63ED9F4D45DF6DF_20200114_1_1_1051.mp4"}]}}]},"ID":"123"}],"Mute":true}`)
 	got, err := json.Marshal(v)
 	t.Logf("%s", got)
 	if err != nil {
@@ -92,7 +91,7 @@ func TestEmptyVast(t *testing.T) {
 			{CDATA: "http://xx.xx.com/e/error?e=__ERRORCODE__&co=__CONTENTPLAYHEAD__&ca=__CACHEBUSTING__&a=__ASSETURI__&t=__TIMESTAMP__&o=__OTHER__"},
 		},
 	}
-	want := []byte(`{"Version":"3.0","Errors":[{"Data":"http://xx.xx.com/e/error?e=__ERRORCODE__\u0026co=__CONTENTPLAYHEAD__\u0026ca=__CACHEBUSTING__\u0026a=__ASSETURI__\u0026t=__TIMESTAMP__\u0026o=__OTHER__"}]}`)
+	want := []byte(`{"Version":"3.0","Errors":["http://xx.xx.com/e/error?e=__ERRORCODE__\u0026co=__CONTENTPLAYHEAD__\u0026ca=__CACHEBUSTING__\u0026a=__ASSETURI__\u0026t=__TIMESTAMP__\u0026o=__OTHER__"]}`)
 	got, err := json.Marshal(v)
 	if err != nil {
 		t.Errorf("Marshal() error = %v", err)
@@ -192,13 +191,13 @@ func BenchmarkVastMarshalXML(b *testing.B) {
 
 func BenchmarkVastMarshalJson(b *testing.B) {
 
-	want := []byte(`{"Version":"3.0","xmlns":"http://www.iab.com/VAST","Ad":[{"ID":"123","Type":"front","InLine":{"AdSystem":{"Data":"DSP"},"AdTitle":{"Data":"ad title"},"Impressions":[{"ID":"456","URI":"http://impression.track.cn"}],"Creatives":[{"ID":"123456","Linear":{"Duration":"00:00:15","TrackingEvents":[{"Event":"start","URI":"http://track.xxx.com/q/start?xx"}],"MediaFiles":[{"Delivery":"progressive","Type":"video/mp4","Width":1024,"Height":576,"URI":"http://mp4.res.xxx.com/new_video/2020/01/14/1485/335928CBA9D02E95E63ED9F4D45DF6DF_20200114_1_1_1051.mp4"}]}}]}}]}`)
+	want := []byte(`{"Version":"3.0","XMLNS":"http://www.iab.com/VAST","Ads":[{"ID":"123","Type":"front","InLine":{"AdSystem":{"Name":"DSP"},"AdTitle":"ad title","Impressions":[{"ID":"456","URI":"http://impression.track.cn"}],"Creatives":[{"ID":"123456","Linear":{"Duration":"00:00:15","TrackingEvents":[{"Event":"start","URI":"http://track.xxx.com/q/start?xx"}],"MediaFiles":[{"Delivery":"progressive","Type":"video/mp4","Width":1024,"Height":576,"URI":"http://mp4.res.xxx.com/new_video/2020/01/14/1485/335928CBA9D02E95E63ED9F4D45DF6DF_20200114_1_1_1051.mp4"}]}}]}}]}`)
 
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
 		v, _ := createVastDemo()
-		got, err := ffjson.Marshal(v)
+		got, err := json.Marshal(v)
 		if err != nil {
 			b.Errorf("Marshal() error = %v", err)
 			return
@@ -215,13 +214,13 @@ func TestCreateVastJson(t *testing.T) {
 		want    []byte
 		wantErr bool
 	}{
-		{name: "testCase1", want: []byte(`{"Version":"3.0","xmlns":"http://www.iab.com/VAST","Ad":[{"InLine":{"AdSystem":{"Data":"DSP"},"Impressions":[{"ID":"456","URI":"http://impression.track.cn"}],"AdTitle":{"Data":"ad title"},"Creatives":[{"ID":"123456","Linear":{"TrackingEvents":[{"Event":"start","URI":"http://track.xxx.com/q/start?xx"}],"Duration":"00:00:15","MediaFiles":[{"Delivery":"progressive","Type":"video/mp4","Width":1024,"Height":576,"URI":"http://mp4.res.xxx.com/new_video/2020/01/14/1485/335928CBA9D02E95E63ED9F4D45DF6DF_20200114_1_1_1051.mp4"}]}}]},"ID":"123"}]}`),
+		{name: "testCase1", want: []byte(`{"Version":"3.0","XMLNS":"http://www.iab.com/VAST","Ads":[{"InLine":{"AdSystem":{"Name":"DSP"},"Impressions":[{"ID":"456","URI":"http://impression.track.cn"}],"AdTitle":"ad title","Creatives":[{"ID":"123456","Linear":{"TrackingEvents":[{"Event":"start","URI":"http://track.xxx.com/q/start?xx"}],"Duration":"00:00:15","MediaFiles":[{"Delivery":"progressive","Type":"video/mp4","Width":1024,"Height":576,"URI":"http://mp4.res.xxx.com/new_video/2020/01/14/1485/335928CBA9D02E95E63ED9F4D45DF6DF_20200114_1_1_1051.mp4"}]}}]},"ID":"123"}]}`),
 			wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			v, _ := createVastDemo()
-			got, err := ffjson.Marshal(v)
+			got, err := json.Marshal(v)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Marshal() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -302,10 +301,10 @@ func TestCreativeExtensions(t *testing.T) {
 					assert.Equal(t, "activeview", ext.Type)
 					if assert.Len(t, ext.CustomTracking, 2) {
 						// first tracker
-						assert.Equal(t, "viewable_impression", ext.CustomTracking[0].Event)
+						assert.Equal(t, EventType("viewable_impression"), ext.CustomTracking[0].Event)
 						assert.Equal(t, "https://pubads.g.doubleclick.net/pagead/conversion/?ai=test&label=viewable_impression&acvw=[VIEWABILITY]&gv=[GOOGLE_VIEWABILITY]&ad_mt=[AD_MT]", ext.CustomTracking[0].URI)
 						// second tracker
-						assert.Equal(t, "abandon", ext.CustomTracking[1].Event)
+						assert.Equal(t, EventType("abandon"), ext.CustomTracking[1].Event)
 						assert.Equal(t, "https://pubads.g.doubleclick.net/pagead/conversion/?ai=test&label=video_abandon&acvw=[VIEWABILITY]&gv=[GOOGLE_VIEWABILITY]", ext.CustomTracking[1].URI)
 					}
 					assert.Empty(t, string(ext.Data))
@@ -350,10 +349,10 @@ func TestInlineExtensions(t *testing.T) {
 					assert.Equal(t, "activeview", ext.Type)
 					if assert.Len(t, ext.CustomTracking, 2) {
 						// first tracker
-						assert.Equal(t, "viewable_impression", ext.CustomTracking[0].Event)
+						assert.Equal(t, EventType("viewable_impression"), ext.CustomTracking[0].Event)
 						assert.Equal(t, "https://pubads.g.doubleclick.net/pagead/conversion/?ai=test&label=viewable_impression&acvw=[VIEWABILITY]&gv=[GOOGLE_VIEWABILITY]&ad_mt=[AD_MT]", ext.CustomTracking[0].URI)
 						// second tracker
-						assert.Equal(t, "abandon", ext.CustomTracking[1].Event)
+						assert.Equal(t, EventType("abandon"), ext.CustomTracking[1].Event)
 						assert.Equal(t, "https://pubads.g.doubleclick.net/pagead/conversion/?ai=test&label=video_abandon&acvw=[VIEWABILITY]&gv=[GOOGLE_VIEWABILITY]", ext.CustomTracking[1].URI)
 					}
 					assert.Empty(t, string(ext.Data))
@@ -409,9 +408,9 @@ func TestInlineLinear(t *testing.T) {
 					linear := crea1.Linear
 					assert.Equal(t, Duration(30*time.Second), linear.Duration)
 					if assert.Len(t, linear.TrackingEvents, 6) {
-						assert.Equal(t, linear.TrackingEvents[0].Event, "creativeView")
+						assert.Equal(t, linear.TrackingEvents[0].Event, Event_type_creativeView)
 						assert.Equal(t, linear.TrackingEvents[0].URI, "http://myTrackingURL/creativeView")
-						assert.Equal(t, linear.TrackingEvents[1].Event, "start")
+						assert.Equal(t, linear.TrackingEvents[1].Event, Event_type_start)
 						assert.Equal(t, linear.TrackingEvents[1].URI, "http://myTrackingURL/start")
 					}
 					if assert.NotNil(t, linear.VideoClicks) {
@@ -441,7 +440,7 @@ func TestInlineLinear(t *testing.T) {
 				assert.Nil(t, crea2.NonLinearAds)
 				assert.Nil(t, crea2.Linear)
 				if assert.NotNil(t, crea2.CompanionAds) {
-					assert.Equal(t, "all", crea2.CompanionAds.Required)
+					assert.Equal(t, CompanionsRequiredAll, crea2.CompanionAds.Required)
 					if assert.Len(t, crea2.CompanionAds.Companions, 2) {
 						comp1 := crea2.CompanionAds.Companions[0]
 						assert.Equal(t, 300, comp1.Width)
@@ -451,7 +450,7 @@ func TestInlineLinear(t *testing.T) {
 							assert.Equal(t, "http://demo.tremormedia.com/proddev/vast/Blistex1.jpg", comp1.StaticResource.URI)
 						}
 						if assert.Len(t, comp1.TrackingEvents, 1) {
-							assert.Equal(t, "creativeView", comp1.TrackingEvents[0].Event)
+							assert.Equal(t, Event_type_creativeView, comp1.TrackingEvents[0].Event)
 							assert.Equal(t, "http://myTrackingURL/firstCompanionCreativeView", comp1.TrackingEvents[0].URI)
 						}
 						assert.Equal(t, "http://www.tremormedia.com", comp1.CompanionClickThrough.CDATA)
@@ -525,9 +524,9 @@ func TestInlineNonLinear(t *testing.T) {
 				if assert.NotNil(t, crea1.NonLinearAds) {
 					nonlin := crea1.NonLinearAds
 					if assert.Len(t, nonlin.TrackingEvents, 5) {
-						assert.Equal(t, nonlin.TrackingEvents[0].Event, "creativeView")
+						assert.Equal(t, nonlin.TrackingEvents[0].Event, Event_type_creativeView)
 						assert.Equal(t, nonlin.TrackingEvents[0].URI, "http://myTrackingURL/nonlinear/creativeView")
-						assert.Equal(t, nonlin.TrackingEvents[1].Event, "expand")
+						assert.Equal(t, nonlin.TrackingEvents[1].Event, Event_type_expand)
 						assert.Equal(t, nonlin.TrackingEvents[1].URI, "http://myTrackingURL/nonlinear/expand")
 					}
 					if assert.Len(t, nonlin.NonLinears, 2) {
@@ -562,7 +561,7 @@ func TestInlineNonLinear(t *testing.T) {
 							assert.Equal(t, "http://demo.tremormedia.com/proddev/vast/728x90_banner1.jpg", comp2.StaticResource.URI)
 						}
 						if assert.Len(t, comp2.TrackingEvents, 1) {
-							assert.Equal(t, "creativeView", comp2.TrackingEvents[0].Event)
+							assert.Equal(t, Event_type_creativeView, comp2.TrackingEvents[0].Event)
 							assert.Equal(t, "http://myTrackingURL/secondCompanion", comp2.TrackingEvents[0].URI)
 						}
 						assert.Equal(t, "http://www.tremormedia.com", comp2.CompanionClickThrough.CDATA)
@@ -607,9 +606,9 @@ func TestWrapperLinear(t *testing.T) {
 				if assert.NotNil(t, crea1.Linear) {
 					linear := crea1.Linear
 					if assert.Len(t, linear.TrackingEvents, 11) {
-						assert.Equal(t, linear.TrackingEvents[0].Event, "creativeView")
+						assert.Equal(t, linear.TrackingEvents[0].Event, Event_type_creativeView)
 						assert.Equal(t, linear.TrackingEvents[0].URI, "http://myTrackingURL/wrapper/creativeView")
-						assert.Equal(t, linear.TrackingEvents[1].Event, "start")
+						assert.Equal(t, linear.TrackingEvents[1].Event, Event_type_start)
 						assert.Equal(t, linear.TrackingEvents[1].URI, "http://myTrackingURL/wrapper/start")
 					}
 					assert.Nil(t, linear.VideoClicks)
@@ -631,7 +630,7 @@ func TestWrapperLinear(t *testing.T) {
 				assert.Nil(t, crea3.Linear)
 				if assert.NotNil(t, crea3.NonLinearAds) {
 					if assert.Len(t, crea3.NonLinearAds.TrackingEvents, 1) {
-						assert.Equal(t, "creativeView", crea3.NonLinearAds.TrackingEvents[0].Event)
+						assert.Equal(t, Event_type_creativeView, crea3.NonLinearAds.TrackingEvents[0].Event)
 						assert.Equal(t, "http://myTrackingURL/wrapper/creativeView", crea3.NonLinearAds.TrackingEvents[0].URI)
 					}
 				}
@@ -676,7 +675,7 @@ func TestWrapperNonLinear(t *testing.T) {
 				assert.Nil(t, crea2.Linear)
 				if assert.NotNil(t, crea2.NonLinearAds) {
 					if assert.Len(t, crea2.NonLinearAds.TrackingEvents, 5) {
-						assert.Equal(t, "creativeView", crea2.NonLinearAds.TrackingEvents[0].Event)
+						assert.Equal(t, Event_type_creativeView, crea2.NonLinearAds.TrackingEvents[0].Event)
 						assert.Equal(t, "http://myTrackingURL/wrapper/nonlinear/creativeView/creativeView", crea2.NonLinearAds.TrackingEvents[0].URI)
 					}
 				}
@@ -850,7 +849,7 @@ func TestIcons(t *testing.T) {
 						if assert.NotNil(t, icon1.StaticResource) {
 							assert.Equal(t, "image/png", icon1.StaticResource.CreativeType)
 							assert.Equal(t, "https://s.aolcdn.com/ads/adchoices.png", icon1.StaticResource.URI)
-							assert.Equal(t, "https://adinfo.aol.com", icon1.IconClickThrough.CDATA)
+							assert.Equal(t, "https://adinfo.aol.com", icon1.IconClicks.IconClickThrough.CDATA)
 						}
 					}
 				}
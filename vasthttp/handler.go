@@ -0,0 +1,253 @@
+// Package vasthttp serves VAST documents over HTTP, with the headers expected
+// by video players such as the IMA SDK.
+package vasthttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	vast "github.com/zattoo/go-vast"
+)
+
+const (
+	// ContentTypeXML is the Content-Type of XML responses.
+	ContentTypeXML = "application/xml; charset=utf-8"
+	// ContentTypeJSON is the Content-Type of JSON responses.
+	ContentTypeJSON = "application/json; charset=utf-8"
+)
+
+// NoAd returns an empty VAST document telling the player no ad is available,
+// with the URLs it should request upon receiving it.
+func NoAd(version string, errorURIs ...string) *vast.VAST {
+	v := &vast.VAST{Version: version}
+	for _, uri := range errorURIs {
+		v.Errors = append(v.Errors, vast.CDATAString{CDATA: uri})
+	}
+	return v
+}
+
+// Writer writes documents to HTTP responses.
+type Writer struct {
+	// The Cache-Control header of the responses. When empty, VAST documents
+	// are cached for the smallest <Expires> of their ads, and other responses
+	// are not cached.
+	CacheControl string
+	// Tells whether cross-origin requests from the given origin are allowed.
+	// All origins are allowed when nil.
+	AllowOrigin func(origin string) bool
+	// Disables the compression of the responses.
+	DisableGzip bool
+	// Called with the serialized document before it is written, typically to
+	// expand macros with values depending on the request.
+	Macros func(r *http.Request, body []byte) []byte
+}
+
+// Write writes doc to w in the format accepted by the request: JSON when
+// preferred by the Accept header, XML otherwise. Any document can be written,
+// such as a VMAP.
+func (wr *Writer) Write(w http.ResponseWriter, r *http.Request, doc interface{}) error {
+	h := w.Header()
+	wr.cors(h, r)
+	h.Add("Vary", "Accept")
+
+	var (
+		body []byte
+		err  error
+	)
+	if acceptsJSON(r.Header.Get("Accept")) {
+		h.Set("Content-Type", ContentTypeJSON)
+		body, err = json.Marshal(doc)
+	} else {
+		h.Set("Content-Type", ContentTypeXML)
+		body, err = xml.Marshal(doc)
+		body = append([]byte(xml.Header), body...)
+	}
+	if err != nil {
+		return err
+	}
+	if wr.Macros != nil {
+		body = wr.Macros(r, body)
+	}
+
+	h.Set("Cache-Control", wr.cacheControl(doc))
+	if !wr.DisableGzip {
+		h.Add("Vary", "Accept-Encoding")
+		if acceptsGzip(r.Header.Get("Accept-Encoding")) {
+			var buf bytes.Buffer
+			zw := gzip.NewWriter(&buf)
+			zw.Write(body)
+			if err := zw.Close(); err != nil {
+				return err
+			}
+			body = buf.Bytes()
+			h.Set("Content-Encoding", "gzip")
+		}
+	}
+	h.Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return nil
+	}
+	_, err = w.Write(body)
+	return err
+}
+
+// cors sets the CORS headers of a response. The IMA SDK sends credentials
+// with its requests, the origin must be echoed rather than "*".
+func (wr *Writer) cors(h http.Header, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		h.Set("Access-Control-Allow-Origin", "*")
+		return
+	}
+	h.Add("Vary", "Origin")
+	if wr.AllowOrigin != nil && !wr.AllowOrigin(origin) {
+		return
+	}
+	h.Set("Access-Control-Allow-Origin", origin)
+	h.Set("Access-Control-Allow-Credentials", "true")
+}
+
+func (wr *Writer) cacheControl(doc interface{}) string {
+	if wr.CacheControl != "" {
+		return wr.CacheControl
+	}
+	if v, ok := doc.(*vast.VAST); ok {
+		if ttl, ok := vast.CacheTTL(v, nil, time.Now()); ok && ttl > 0 {
+			return fmt.Sprintf("max-age=%d", int(ttl/time.Second))
+		}
+	}
+	return "no-store"
+}
+
+// preflight answers a CORS preflight request.
+func (wr *Writer) preflight(w http.ResponseWriter, r *http.Request) {
+	h := w.Header()
+	wr.cors(h, r)
+	h.Set("Access-Control-Allow-Methods", "GET, HEAD, POST, OPTIONS")
+	if rh := r.Header.Get("Access-Control-Request-Headers"); rh != "" {
+		h.Set("Access-Control-Allow-Headers", rh)
+	}
+	h.Set("Access-Control-Max-Age", "86400")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Handler is an http.Handler serving the VAST documents returned by Source.
+type Handler struct {
+	Writer
+	// Returns the document to serve for a request. A nil document is served
+	// as a no-ad response.
+	Source func(r *http.Request) (*vast.VAST, error)
+	// The version of the no-ad responses, 4.2 when empty.
+	NoAdVersion string
+	// The URLs of the no-ad responses, requested by the player upon
+	// receiving them.
+	NoAdErrors []string
+	// Called when Source fails, or when the document cannot be written
+	// before anything was sent. A 500 Internal Server Error is answered
+	// when nil.
+	Error func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (hd *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		hd.preflight(w, r)
+		return
+	}
+	v, err := hd.Source(r)
+	if err != nil {
+		hd.fail(w, r, err)
+		return
+	}
+	if v == nil {
+		version := hd.NoAdVersion
+		if version == "" {
+			version = "4.2"
+		}
+		v = NoAd(version, hd.NoAdErrors...)
+	}
+	rw := &responseWriter{ResponseWriter: w}
+	if err := hd.Write(rw, r, v); err != nil && !rw.wroteHeader {
+		// drop the headers of the failed document
+		h := w.Header()
+		for _, k := range []string{"Cache-Control", "Content-Encoding", "Content-Length", "Content-Type"} {
+			h.Del(k)
+		}
+		hd.fail(w, r, err)
+	}
+}
+
+func (hd *Handler) fail(w http.ResponseWriter, r *http.Request, err error) {
+	if hd.Error != nil {
+		hd.Error(w, r, err)
+		return
+	}
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// responseWriter records whether the response was started.
+type responseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// acceptsJSON returns whether an Accept header prefers JSON over XML.
+func acceptsJSON(accept string) bool {
+	var jsonQ, xmlQ float64 = -1, -1
+	for _, mr := range strings.Split(accept, ",") {
+		typ, q := mediaRange(mr)
+		switch typ {
+		case "application/json":
+			jsonQ = q
+		case "application/xml", "text/xml":
+			if q > xmlQ {
+				xmlQ = q
+			}
+		}
+	}
+	return jsonQ > 0 && jsonQ > xmlQ
+}
+
+// acceptsGzip returns whether an Accept-Encoding header accepts gzip.
+func acceptsGzip(accept string) bool {
+	for _, e := range strings.Split(accept, ",") {
+		if typ, q := mediaRange(e); (typ == "gzip" || typ == "x-gzip") && q > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// mediaRange returns the lower cased value and the quality of an element of
+// an Accept header.
+func mediaRange(s string) (string, float64) {
+	parts := strings.Split(s, ";")
+	q := 1.0
+	for _, p := range parts[1:] {
+		p = strings.TrimSpace(p)
+		if strings.HasPrefix(p, "q=") {
+			if v, err := strconv.ParseFloat(p[2:], 64); err == nil {
+				q = v
+			}
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(parts[0])), q
+}
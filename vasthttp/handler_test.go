@@ -0,0 +1,181 @@
+package vasthttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vast "github.com/zattoo/go-vast"
+)
+
+func testAd() *vast.VAST {
+	return &vast.VAST{
+		Version: "3.0",
+		Ads: []vast.Ad{{
+			ID: "1",
+			InLine: &vast.InLine{
+				AdSystem:    &vast.AdSystem{Name: "DSP"},
+				AdTitle:     vast.CDATAString{CDATA: "title"},
+				Impressions: []vast.Impression{{URI: "http://example.com/impression?cb=[CACHEBUSTING]"}},
+			},
+		}},
+	}
+}
+
+func serve(h http.Handler, r *http.Request) *http.Response {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Result()
+}
+
+func TestHandlerXML(t *testing.T) {
+	h := &Handler{
+		Source: func(r *http.Request) (*vast.VAST, error) { return testAd(), nil },
+		Writer: Writer{
+			Macros: func(r *http.Request, body []byte) []byte {
+				return bytes.Replace(body, []byte("[CACHEBUSTING]"), []byte(r.URL.Query().Get("cb")), -1)
+			},
+		},
+	}
+	r := httptest.NewRequest(http.MethodGet, "/vast?cb=1234", nil)
+	r.Header.Set("Origin", "https://player.example.com")
+	resp := serve(h, r)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, ContentTypeXML, resp.Header.Get("Content-Type"))
+	assert.Equal(t, "https://player.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", resp.Header.Get("Access-Control-Allow-Credentials"))
+	assert.Equal(t, "no-store", resp.Header.Get("Cache-Control"))
+	assert.Equal(t, []string{"Origin", "Accept", "Accept-Encoding"}, resp.Header["Vary"])
+	assert.Empty(t, resp.Header.Get("Content-Encoding"))
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<VAST version="3.0"><Ad id="1"><InLine><AdSystem><![CDATA[DSP]]></AdSystem>`+
+		`<Impression><![CDATA[http://example.com/impression?cb=1234]]></Impression>`+
+		`<AdTitle><![CDATA[title]]></AdTitle><Creatives></Creatives></InLine></Ad></VAST>`, string(body))
+
+	v, err := vast.Decode(bytes.NewReader(body))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "http://example.com/impression?cb=1234", v.Ads[0].InLine.Impressions[0].URI)
+	}
+}
+
+func TestHandlerGzipJSON(t *testing.T) {
+	v := testAd()
+	v.Ads[0].InLine.Expires = 300
+	h := &Handler{Source: func(r *http.Request) (*vast.VAST, error) { return v, nil }}
+	r := httptest.NewRequest(http.MethodGet, "/vast", nil)
+	r.Header.Set("Accept", "application/xml;q=0.9, application/json")
+	r.Header.Set("Accept-Encoding", "gzip, deflate")
+	resp := serve(h, r)
+	assert.Equal(t, ContentTypeJSON, resp.Header.Get("Content-Type"))
+	assert.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+	assert.Equal(t, "*", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "max-age=300", resp.Header.Get("Cache-Control"))
+
+	zr, err := gzip.NewReader(resp.Body)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var got vast.VAST
+	if assert.NoError(t, json.NewDecoder(zr).Decode(&got)) {
+		assert.Equal(t, v, &got)
+	}
+}
+
+func TestHandlerNoAd(t *testing.T) {
+	h := &Handler{
+		Source:     func(r *http.Request) (*vast.VAST, error) { return nil, nil },
+		NoAdErrors: []string{"http://example.com/error?code=[ERRORCODE]"},
+		Writer:     Writer{CacheControl: "no-cache", DisableGzip: true},
+	}
+	r := httptest.NewRequest(http.MethodGet, "/vast", nil)
+	r.Header.Set("Accept-Encoding", "gzip")
+	resp := serve(h, r)
+	assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+	assert.Empty(t, resp.Header.Get("Content-Encoding"))
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<VAST version="4.2"><Error><![CDATA[http://example.com/error?code=[ERRORCODE]]]></Error></VAST>`, string(body))
+}
+
+func TestHandlerErrors(t *testing.T) {
+	h := &Handler{Source: func(r *http.Request) (*vast.VAST, error) { return nil, errors.New("boom") }}
+	resp := serve(h, httptest.NewRequest(http.MethodGet, "/vast", nil))
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	h.Error = func(w http.ResponseWriter, r *http.Request, err error) {
+		h.Write(w, r, NoAd("3.0"))
+	}
+	resp = serve(h, httptest.NewRequest(http.MethodGet, "/vast", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Contains(t, string(body), `<VAST version="3.0"></VAST>`)
+}
+
+func TestHandlerWriteError(t *testing.T) {
+	v := testAd()
+	v.Ads[0].InLine.Creatives = []vast.Creative{{Linear: &vast.Linear{
+		Icons: &vast.Icons{Icon: []vast.Icon{{PxRatio: math.NaN()}}},
+	}}}
+	h := &Handler{Source: func(r *http.Request) (*vast.VAST, error) { return v, nil }}
+	r := httptest.NewRequest(http.MethodGet, "/vast", nil)
+	r.Header.Set("Accept", "application/json")
+	resp := serve(h, r)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("Cache-Control"))
+
+	var failed error
+	h.Error = func(w http.ResponseWriter, r *http.Request, err error) {
+		failed = err
+		w.WriteHeader(http.StatusBadGateway)
+	}
+	resp = serve(h, r)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Error(t, failed)
+}
+
+func TestHandlerCORS(t *testing.T) {
+	h := &Handler{
+		Source: func(r *http.Request) (*vast.VAST, error) { return testAd(), nil },
+		Writer: Writer{AllowOrigin: func(origin string) bool { return origin == "https://allowed.example.com" }},
+	}
+	r := httptest.NewRequest(http.MethodOptions, "/vast", nil)
+	r.Header.Set("Origin", "https://allowed.example.com")
+	r.Header.Set("Access-Control-Request-Headers", "X-Player")
+	resp := serve(h, r)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "https://allowed.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "X-Player", resp.Header.Get("Access-Control-Allow-Headers"))
+
+	r = httptest.NewRequest(http.MethodGet, "/vast", nil)
+	r.Header.Set("Origin", "https://other.example.com")
+	resp = serve(h, r)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("Access-Control-Allow-Origin"))
+
+	resp = serve(h, httptest.NewRequest(http.MethodHead, "/vast", nil))
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Empty(t, body)
+	assert.NotEqual(t, "0", resp.Header.Get("Content-Length"))
+}
+
+func TestAcceptsJSON(t *testing.T) {
+	for accept, want := range map[string]bool{
+		"":                                  false,
+		"*/*":                               false,
+		"application/json":                  true,
+		"application/xml, application/json": false,
+		"text/xml;q=0.5, application/json":  true,
+		"application/json;q=0":              false,
+	} {
+		assert.Equal(t, want, acceptsJSON(accept), accept)
+	}
+}
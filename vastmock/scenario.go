@@ -0,0 +1,135 @@
+// Package vastmock provides a local ad server for player integration tests,
+// serving VAST responses described by a declarative scenario and recording
+// the beacons requested by the player.
+package vastmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// ResponseKind is the kind of response served for a tag.
+type ResponseKind string
+
+const (
+	// An InLine ad with a linear creative
+	ResponseInLine ResponseKind = "inline"
+	// A Wrapper ad pointing to the Next tag
+	ResponseWrapper ResponseKind = "wrapper"
+	// An empty VAST document, with an <Error> beacon
+	ResponseNoAd ResponseKind = "noad"
+	// A truncated VAST document
+	ResponseMalformed ResponseKind = "malformed"
+	// No response until the client gives up
+	ResponseTimeout ResponseKind = "timeout"
+	// A redirection to the Next tag
+	ResponseRedirect ResponseKind = "redirect"
+	// An empty response with the given Status
+	ResponseStatus ResponseKind = "status"
+)
+
+// Delay is a duration parsed from strings such as "1.5s" or "200ms".
+type Delay time.Duration
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d Delay) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *Delay) UnmarshalText(data []byte) error {
+	v, err := time.ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = Delay(v)
+	return nil
+}
+
+// Tag describes the response served for an ad tag.
+type Tag struct {
+	Response ResponseKind `json:"response"`
+	// The tag a wrapper or a redirection points to
+	Next string `json:"next,omitempty"`
+	// The time to wait before responding
+	Delay Delay `json:"delay,omitempty"`
+	// The HTTP status of "status" responses
+	Status int `json:"status,omitempty"`
+	// The HTTP status of the media file of an InLine ad, e.g. 404. The media
+	// file is served when zero.
+	MediaStatus int `json:"mediaStatus,omitempty"`
+}
+
+// Scenario describes the tags served by a Server, by name. A tag is served at
+// /tags/{name}.
+//
+// Scenarios can be written in JSON:
+//
+//	{
+//	  "tags": {
+//	    "start": {"response": "wrapper", "next": "slow"},
+//	    "slow": {"response": "inline", "delay": "500ms", "mediaStatus": 404}
+//	  }
+//	}
+type Scenario struct {
+	Tags map[string]Tag `json:"tags"`
+}
+
+// LoadScenario reads a scenario in JSON from r.
+func LoadScenario(r io.Reader) (*Scenario, error) {
+	var s Scenario
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, err
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Validate checks that the tags of the scenario are well defined.
+func (s *Scenario) Validate() error {
+	for name, t := range s.Tags {
+		if t.Delay < 0 {
+			return fmt.Errorf("vastmock: tag %q has a negative delay %s", name, time.Duration(t.Delay))
+		}
+		if t.MediaStatus != 0 && !validStatus(t.MediaStatus) {
+			return fmt.Errorf("vastmock: tag %q has an invalid media status %d", name, t.MediaStatus)
+		}
+		switch t.Response {
+		case ResponseInLine, ResponseNoAd, ResponseMalformed, ResponseTimeout:
+		case ResponseWrapper, ResponseRedirect:
+			if _, ok := s.Tags[t.Next]; !ok {
+				return fmt.Errorf("vastmock: tag %q points to unknown tag %q", name, t.Next)
+			}
+		case ResponseStatus:
+			if !validStatus(t.Status) {
+				return fmt.Errorf("vastmock: tag %q has an invalid status %d", name, t.Status)
+			}
+		default:
+			return fmt.Errorf("vastmock: tag %q has an unknown response %q", name, t.Response)
+		}
+	}
+	return nil
+}
+
+func validStatus(status int) bool {
+	return status >= 100 && status <= 599
+}
+
+// WrapperChain returns a scenario of depth wrappers, named "wrapper0" to
+// "wrapperN", ending with an InLine named "inline". The first tag is
+// "wrapper0", or "inline" when depth is zero.
+func WrapperChain(depth int) *Scenario {
+	s := &Scenario{Tags: map[string]Tag{"inline": {Response: ResponseInLine}}}
+	next := "inline"
+	for i := depth - 1; i >= 0; i-- {
+		name := "wrapper" + strconv.Itoa(i)
+		s.Tags[name] = Tag{Response: ResponseWrapper, Next: next}
+		next = name
+	}
+	return s
+}
@@ -0,0 +1,278 @@
+package vastmock
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	vast "github.com/zattoo/go-vast"
+)
+
+// BeaconKind is the kind of a beacon requested by the player.
+type BeaconKind string
+
+// The kinds of beacons of the served ads.
+const (
+	BeaconImpression BeaconKind = "impression"
+	BeaconTracking   BeaconKind = "tracking"
+	BeaconError      BeaconKind = "error"
+	BeaconClick      BeaconKind = "click"
+)
+
+// Beacon is a beacon request recorded by a Server.
+type Beacon struct {
+	// The tag the beacon belongs to
+	Tag  string
+	Kind BeaconKind
+	// The event of tracking beacons
+	Event vast.EventType
+	// The query of the request, e.g. the code of error beacons
+	Query url.Values
+}
+
+// Server is a mock ad server serving the tags of a scenario.
+type Server struct {
+	*httptest.Server
+	scenario *Scenario
+
+	mu      sync.Mutex
+	beacons []Beacon
+	// closed when the server is closed, to release the pending timeouts
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewServer starts a server serving the given scenario. It must be closed
+// once done.
+func NewServer(s *Scenario) *Server {
+	srv := &Server{scenario: s, done: make(chan struct{})}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/tags/", srv.serveTag)
+	mux.HandleFunc("/beacons/", srv.serveBeacon)
+	mux.HandleFunc("/media/", srv.serveMedia)
+	srv.Server = httptest.NewServer(mux)
+	return srv
+}
+
+// Close shuts down the server. It may be called several times.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.Server.Close()
+	})
+}
+
+// TagURL returns the URL of a tag of the scenario.
+func (s *Server) TagURL(name string) string {
+	return s.URL + "/tags/" + url.PathEscape(name)
+}
+
+// Beacons returns the beacons requested so far, in order.
+func (s *Server) Beacons() []Beacon {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Beacon(nil), s.beacons...)
+}
+
+// BeaconsOf returns the beacons of the given kind requested so far.
+func (s *Server) BeaconsOf(kind BeaconKind) []Beacon {
+	var res []Beacon
+	for _, b := range s.Beacons() {
+		if b.Kind == kind {
+			res = append(res, b)
+		}
+	}
+	return res
+}
+
+// Reset forgets the recorded beacons.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.beacons = nil
+}
+
+func (s *Server) serveTag(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/tags/")
+	t, ok := s.scenario.Tags[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if !s.wait(r, time.Duration(t.Delay)) {
+		return
+	}
+
+	var v *vast.VAST
+	switch t.Response {
+	case ResponseTimeout:
+		s.wait(r, -1)
+		return
+	case ResponseRedirect:
+		http.Redirect(w, r, s.TagURL(t.Next), http.StatusFound)
+		return
+	case ResponseStatus:
+		w.WriteHeader(t.Status)
+		return
+	case ResponseInLine:
+		v = s.inline(name)
+	case ResponseWrapper:
+		v = s.wrapper(name, t)
+	default:
+		v = &vast.VAST{Version: "3.0", Errors: []vast.CDATAString{{CDATA: s.beaconURL(name, BeaconError, "") + "?code=[ERRORCODE]"}}}
+	}
+
+	b, err := xml.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	b = append([]byte(xml.Header), b...)
+	if t.Response == ResponseMalformed {
+		b = b[:len(b)/2]
+	}
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Write(b)
+}
+
+// wait waits for d, forever when negative, returning false when the request
+// was canceled or the server closed before.
+func (s *Server) wait(r *http.Request, d time.Duration) bool {
+	if d == 0 {
+		return true
+	}
+	var timeout <-chan time.Time
+	if d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-timeout:
+		return true
+	case <-r.Context().Done():
+	case <-s.done:
+	}
+	return false
+}
+
+func (s *Server) serveBeacon(w http.ResponseWriter, r *http.Request) {
+	// the segments are unescaped one by one, the tag names may contain "/"
+	parts := strings.Split(strings.TrimPrefix(r.URL.EscapedPath(), "/beacons/"), "/")
+	if len(parts) < 2 {
+		http.NotFound(w, r)
+		return
+	}
+	for i, p := range parts {
+		var err error
+		if parts[i], err = url.PathUnescape(p); err != nil {
+			http.NotFound(w, r)
+			return
+		}
+	}
+	b := Beacon{Tag: parts[0], Kind: BeaconKind(parts[1]), Query: r.URL.Query()}
+	if len(parts) > 2 {
+		b.Event = vast.EventType(parts[2])
+	}
+	s.mu.Lock()
+	s.beacons = append(s.beacons, b)
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) serveMedia(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/media/"), ".mp4")
+	t, ok := s.scenario.Tags[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if t.MediaStatus != 0 {
+		w.WriteHeader(t.MediaStatus)
+		return
+	}
+	w.Header().Set("Content-Type", "video/mp4")
+	// not a playable file, players under test are not expected to decode it
+	w.Write(make([]byte, 1024))
+}
+
+func (s *Server) beaconURL(tag string, kind BeaconKind, event vast.EventType) string {
+	u := s.URL + "/beacons/" + url.PathEscape(tag) + "/" + string(kind)
+	if event != "" {
+		u += "/" + url.PathEscape(string(event))
+	}
+	return u
+}
+
+// trackingEvents returns the tracking beacons of the quartiles of a linear
+// creative.
+func (s *Server) trackingEvents(tag string) vast.TrackingEvents {
+	var ts vast.TrackingEvents
+	for _, e := range []vast.EventType{
+		vast.Event_type_start, vast.Event_type_firstQuartile, vast.Event_type_midpoint,
+		vast.Event_type_thirdQuartile, vast.Event_type_complete,
+	} {
+		ts = append(ts, vast.Tracking{Event: e, URI: s.beaconURL(tag, BeaconTracking, e)})
+	}
+	return ts
+}
+
+func (s *Server) inline(name string) *vast.VAST {
+	return &vast.VAST{
+		Version: "3.0",
+		Ads: []vast.Ad{{
+			ID: name,
+			InLine: &vast.InLine{
+				AdSystem:    &vast.AdSystem{Name: "vastmock"},
+				AdTitle:     vast.CDATAString{CDATA: name},
+				Impressions: []vast.Impression{{URI: s.beaconURL(name, BeaconImpression, "")}},
+				Errors:      []vast.CDATAString{{CDATA: s.beaconURL(name, BeaconError, "") + "?code=[ERRORCODE]"}},
+				Creatives: []vast.Creative{{
+					ID: name,
+					Linear: &vast.Linear{
+						Duration:       vast.Duration(15 * time.Second),
+						TrackingEvents: s.trackingEvents(name),
+						VideoClicks: &vast.VideoClicks{
+							ClickThroughs:  []vast.VideoClick{{URI: "https://example.com"}},
+							ClickTrackings: []vast.VideoClick{{URI: s.beaconURL(name, BeaconClick, "")}},
+						},
+						MediaFiles: []vast.MediaFile{{
+							Delivery: "progressive",
+							Type:     "video/mp4",
+							Width:    1280,
+							Height:   720,
+							URI:      s.URL + "/media/" + url.PathEscape(name) + ".mp4",
+						}},
+					},
+				}},
+			},
+		}},
+	}
+}
+
+func (s *Server) wrapper(name string, t Tag) *vast.VAST {
+	return &vast.VAST{
+		Version: "3.0",
+		Ads: []vast.Ad{{
+			ID: name,
+			Wrapper: &vast.Wrapper{
+				AdSystem:     &vast.AdSystem{Name: "vastmock"},
+				VASTAdTagURI: vast.CDATAString{CDATA: s.TagURL(t.Next)},
+				Impressions:  []vast.Impression{{URI: s.beaconURL(name, BeaconImpression, "")}},
+				Errors:       []vast.CDATAString{{CDATA: s.beaconURL(name, BeaconError, "") + "?code=[ERRORCODE]"}},
+				Creatives: []vast.CreativeWrapper{{
+					Linear: &vast.LinearWrapper{
+						TrackingEvents: s.trackingEvents(name),
+						VideoClicks: &vast.VideoClicks{
+							ClickTrackings: []vast.VideoClick{{URI: s.beaconURL(name, BeaconClick, "")}},
+						},
+					},
+				}},
+			},
+		}},
+	}
+}
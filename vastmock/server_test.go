@@ -0,0 +1,161 @@
+package vastmock
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vast "github.com/zattoo/go-vast"
+)
+
+// unwrap follows the wrappers from the given tag, requesting their
+// impressions, and returns the final document.
+func unwrap(t *testing.T, ctx context.Context, uri string) (*vast.VAST, error) {
+	for {
+		resp, err := vast.HTTPFetcher{}.Fetch(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+		var v vast.VAST
+		if err := vast.Unmarshal(resp.Body, &v); err != nil {
+			return nil, err
+		}
+		if len(v.Ads) == 0 || v.Ads[0].Wrapper == nil {
+			return &v, nil
+		}
+		for _, imp := range v.Ads[0].Wrapper.Impressions {
+			get(t, imp.URI)
+		}
+		uri = v.Ads[0].Wrapper.VASTAdTagURI.CDATA
+	}
+}
+
+func get(t *testing.T, uri string) int {
+	resp, err := http.Get(uri)
+	if !assert.NoError(t, err) {
+		return 0
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestWrapperChain(t *testing.T) {
+	s := NewServer(WrapperChain(3))
+	defer s.Close()
+
+	v, err := unwrap(t, context.Background(), s.TagURL("wrapper0"))
+	if !assert.NoError(t, err) || !assert.Len(t, v.Ads, 1) {
+		return
+	}
+	inline := v.Ads[0].InLine
+	if !assert.NotNil(t, inline) {
+		return
+	}
+	assert.Equal(t, "inline", inline.AdTitle.CDATA)
+	get(t, inline.Impressions[0].URI)
+	linear := inline.Creatives[0].Linear
+	for _, uri := range linear.TrackingEvents.URIs(vast.Event_type_start) {
+		get(t, uri)
+	}
+	assert.Equal(t, http.StatusOK, get(t, linear.MediaFiles[0].URI))
+	get(t, strings.Replace(inline.Errors[0].CDATA, "[ERRORCODE]", "405", 1))
+
+	var tags []string
+	for _, b := range s.BeaconsOf(BeaconImpression) {
+		tags = append(tags, b.Tag)
+	}
+	assert.Equal(t, []string{"wrapper0", "wrapper1", "wrapper2", "inline"}, tags)
+	assert.Equal(t, []Beacon{{Tag: "inline", Kind: BeaconTracking, Event: vast.Event_type_start, Query: map[string][]string{}}}, s.BeaconsOf(BeaconTracking))
+	if errs := s.BeaconsOf(BeaconError); assert.Len(t, errs, 1) {
+		assert.Equal(t, "405", errs[0].Query.Get("code"))
+	}
+
+	s.Reset()
+	assert.Empty(t, s.Beacons())
+}
+
+func TestScenario(t *testing.T) {
+	sc, err := LoadScenario(strings.NewReader(`{
+		"tags": {
+			"redirect": {"response": "redirect", "next": "slow"},
+			"slow": {"response": "inline", "delay": "50ms", "mediaStatus": 404},
+			"noad": {"response": "noad"},
+			"malformed": {"response": "malformed"},
+			"timeout": {"response": "timeout"},
+			"unavailable": {"response": "status", "status": 503}
+		}
+	}`))
+	if !assert.NoError(t, err) {
+		return
+	}
+	s := NewServer(sc)
+	defer s.Close()
+
+	start := time.Now()
+	v, err := unwrap(t, context.Background(), s.TagURL("redirect"))
+	if assert.NoError(t, err) && assert.Len(t, v.Ads, 1) {
+		assert.True(t, time.Since(start) >= 50*time.Millisecond)
+		assert.Equal(t, http.StatusNotFound, get(t, v.Ads[0].InLine.Creatives[0].Linear.MediaFiles[0].URI))
+	}
+
+	v, err = unwrap(t, context.Background(), s.TagURL("noad"))
+	if assert.NoError(t, err) {
+		assert.Empty(t, v.Ads)
+		assert.Len(t, v.Errors, 1)
+	}
+
+	_, err = unwrap(t, context.Background(), s.TagURL("malformed"))
+	assert.Error(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = unwrap(t, ctx, s.TagURL("timeout"))
+	assert.Error(t, err)
+
+	_, err = unwrap(t, context.Background(), s.TagURL("unavailable"))
+	assert.Contains(t, err.Error(), "503 Service Unavailable")
+
+	assert.Equal(t, http.StatusNotFound, get(t, s.TagURL("unknown")))
+}
+
+func TestScenarioValidate(t *testing.T) {
+	for js, msg := range map[string]string{
+		`{"tags": {"a": {"response": "wrapper", "next": "b"}}}`:      `vastmock: tag "a" points to unknown tag "b"`,
+		`{"tags": {"a": {"response": "status"}}}`:                    `vastmock: tag "a" has an invalid status 0`,
+		`{"tags": {"a": {"response": "other"}}}`:                     `vastmock: tag "a" has an unknown response "other"`,
+		`{"tags": {"a": {"response": "inline", "delay": "x"}}}`:      `time: invalid duration "x"`,
+		`{"tags": {"a": {"response": "inline", "delay": "-1s"}}}`:    `vastmock: tag "a" has a negative delay -1s`,
+		`{"tags": {"a": {"response": "status", "status": 1000}}}`:    `vastmock: tag "a" has an invalid status 1000`,
+		`{"tags": {"a": {"response": "status", "status": 600}}}`:     `vastmock: tag "a" has an invalid status 600`,
+		`{"tags": {"a": {"response": "inline", "mediaStatus": 42}}}`: `vastmock: tag "a" has an invalid media status 42`,
+	} {
+		_, err := LoadScenario(strings.NewReader(js))
+		assert.EqualError(t, err, msg)
+	}
+}
+
+func TestServerTagWithSlash(t *testing.T) {
+	s := NewServer(&Scenario{Tags: map[string]Tag{"a/b": {Response: ResponseInLine}}})
+	defer s.Close()
+	v, err := unwrap(t, context.Background(), s.TagURL("a/b"))
+	if !assert.NoError(t, err) || !assert.Len(t, v.Ads, 1) {
+		return
+	}
+	for _, imp := range v.Ads[0].InLine.Impressions {
+		get(t, imp.URI)
+	}
+	beacons := s.Beacons()
+	if assert.Len(t, beacons, 1) {
+		assert.Equal(t, "a/b", beacons[0].Tag)
+		assert.Equal(t, BeaconImpression, beacons[0].Kind)
+	}
+}
+
+func TestServerCloseTwice(t *testing.T) {
+	s := NewServer(WrapperChain(1))
+	s.Close()
+	assert.NotPanics(t, s.Close)
+}
@@ -0,0 +1,841 @@
+package vastpb
+
+import (
+	"time"
+
+	vast "github.com/zattoo/go-vast"
+)
+
+// FromVAST converts a VAST document to its protocol buffers representation.
+func FromVAST(v *vast.VAST) *VAST {
+	if v == nil {
+		return nil
+	}
+	p := &VAST{Version: v.Version, Xmlns: v.XMLNS, Errors: fromCDATAs(v.Errors), Mute: v.Mute}
+	for i := range v.Ads {
+		p.Ads = append(p.Ads, fromAd(&v.Ads[i]))
+	}
+	return p
+}
+
+// ToVAST converts a protocol buffers message back to a VAST document.
+func ToVAST(p *VAST) *vast.VAST {
+	if p == nil {
+		return nil
+	}
+	v := &vast.VAST{Version: p.Version, XMLNS: p.Xmlns, Errors: toCDATAs(p.Errors), Mute: p.Mute}
+	for _, ad := range p.Ads {
+		v.Ads = append(v.Ads, toAd(ad))
+	}
+	return v
+}
+
+func fromAd(ad *vast.Ad) *Ad {
+	return &Ad{
+		InLine:   fromInLine(ad.InLine),
+		Wrapper:  fromWrapper(ad.Wrapper),
+		Id:       ad.ID,
+		Sequence: int64(ad.Sequence),
+		AdType:   ad.AdType,
+	}
+}
+
+func toAd(p *Ad) vast.Ad {
+	return vast.Ad{
+		InLine:   toInLine(p.InLine),
+		Wrapper:  toWrapper(p.Wrapper),
+		ID:       p.Id,
+		Sequence: int(p.Sequence),
+		AdType:   p.AdType,
+	}
+}
+
+func fromInLine(in *vast.InLine) *InLine {
+	if in == nil {
+		return nil
+	}
+	p := &InLine{
+		AdSystem:    fromAdSystem(in.AdSystem),
+		Errors:      fromCDATAs(in.Errors),
+		Extensions:  fromExtensionList(in.Extensions),
+		Impressions: fromImpressions(in.Impressions),
+		AdServingId: in.AdServingId,
+		AdTitle:     in.AdTitle.CDATA,
+		Advertiser:  in.Advertiser,
+		Description: fromOptCDATA(in.Description),
+		Survey:      fromOptCDATA(in.Survey),
+		Expires:     int64(in.Expires),
+	}
+	p.Pricing = fromPricing(in.Pricing)
+	for _, c := range in.Categories {
+		p.Categories = append(p.Categories, &Category{Authority: c.Authority, Code: c.Code})
+	}
+	for i := range in.Creatives {
+		p.Creatives = append(p.Creatives, fromCreative(&in.Creatives[i]))
+	}
+	return p
+}
+
+func toInLine(p *InLine) *vast.InLine {
+	if p == nil {
+		return nil
+	}
+	in := &vast.InLine{
+		AdSystem:    toAdSystem(p.AdSystem),
+		Errors:      toCDATAs(p.Errors),
+		Extensions:  toExtensionList(p.Extensions),
+		Impressions: toImpressions(p.Impressions),
+		AdServingId: p.AdServingId,
+		AdTitle:     vast.CDATAString{CDATA: p.AdTitle},
+		Advertiser:  p.Advertiser,
+		Description: toOptCDATA(p.Description),
+		Survey:      toOptCDATA(p.Survey),
+		Expires:     int(p.Expires),
+	}
+	in.Pricing = toPricing(p.Pricing)
+	for _, c := range p.Categories {
+		in.Categories = append(in.Categories, vast.Category{Authority: c.Authority, Code: c.Code})
+	}
+	for _, c := range p.Creatives {
+		in.Creatives = append(in.Creatives, toCreative(c))
+	}
+	return in
+}
+
+func fromWrapper(w *vast.Wrapper) *Wrapper {
+	if w == nil {
+		return nil
+	}
+	p := &Wrapper{
+		AdSystem:                 fromAdSystem(w.AdSystem),
+		Errors:                   fromCDATAs(w.Errors),
+		Extensions:               fromExtensions(w.Extensions),
+		Impressions:              fromImpressions(w.Impressions),
+		Pricing:                  fromPricing(w.Pricing),
+		VastAdTagUri:             w.VASTAdTagURI.CDATA,
+		FallbackOnNoAd:           w.FallbackOnNoAd,
+		AllowMultipleAds:         w.AllowMultipleAds,
+		FollowAdditionalWrappers: w.FollowAdditionalWrappers,
+	}
+	for i := range w.Creatives {
+		p.Creatives = append(p.Creatives, fromCreativeWrapper(&w.Creatives[i]))
+	}
+	return p
+}
+
+func toWrapper(p *Wrapper) *vast.Wrapper {
+	if p == nil {
+		return nil
+	}
+	w := &vast.Wrapper{
+		AdSystem:                 toAdSystem(p.AdSystem),
+		Errors:                   toCDATAs(p.Errors),
+		Extensions:               toExtensions(p.Extensions),
+		Impressions:              toImpressions(p.Impressions),
+		Pricing:                  toPricing(p.Pricing),
+		VASTAdTagURI:             vast.CDATAString{CDATA: p.VastAdTagUri},
+		FallbackOnNoAd:           p.FallbackOnNoAd,
+		AllowMultipleAds:         p.AllowMultipleAds,
+		FollowAdditionalWrappers: p.FollowAdditionalWrappers,
+	}
+	for _, c := range p.Creatives {
+		w.Creatives = append(w.Creatives, toCreativeWrapper(c))
+	}
+	return w
+}
+
+func fromAdSystem(s *vast.AdSystem) *AdSystem {
+	if s == nil {
+		return nil
+	}
+	return &AdSystem{Version: s.Version, Name: s.Name}
+}
+
+func toAdSystem(p *AdSystem) *vast.AdSystem {
+	if p == nil {
+		return nil
+	}
+	return &vast.AdSystem{Version: p.Version, Name: p.Name}
+}
+
+func fromPricing(p *vast.Pricing) *Pricing {
+	if p == nil {
+		return nil
+	}
+	return &Pricing{Model: string(p.Model), Currency: p.Currency, Value: p.Value}
+}
+
+func toPricing(p *Pricing) *vast.Pricing {
+	if p == nil {
+		return nil
+	}
+	return &vast.Pricing{Model: vast.PricingModel(p.Model), Currency: p.Currency, Value: p.Value}
+}
+
+func fromImpressions(imps []vast.Impression) []*Impression {
+	var res []*Impression
+	for _, imp := range imps {
+		res = append(res, &Impression{Id: imp.ID, Uri: imp.URI})
+	}
+	return res
+}
+
+func toImpressions(ps []*Impression) []vast.Impression {
+	var res []vast.Impression
+	for _, p := range ps {
+		res = append(res, vast.Impression{ID: p.Id, URI: p.Uri})
+	}
+	return res
+}
+
+func fromExtensionList(exts *[]vast.Extension) *Extensions {
+	if exts == nil {
+		return nil
+	}
+	return &Extensions{Extensions: fromExtensions(*exts)}
+}
+
+func toExtensionList(p *Extensions) *[]vast.Extension {
+	if p == nil {
+		return nil
+	}
+	exts := toExtensions(p.Extensions)
+	if exts == nil {
+		exts = []vast.Extension{}
+	}
+	return &exts
+}
+
+func fromExtensions(exts []vast.Extension) []*Extension {
+	var res []*Extension
+	for _, e := range exts {
+		res = append(res, &Extension{Type: e.Type, CustomTracking: fromTrackings(e.CustomTracking), Data: e.Data})
+	}
+	return res
+}
+
+func toExtensions(ps []*Extension) []vast.Extension {
+	var res []vast.Extension
+	for _, p := range ps {
+		res = append(res, vast.Extension{Type: p.Type, CustomTracking: toTrackings(p.CustomTracking), Data: p.Data})
+	}
+	return res
+}
+
+func fromCreative(c *vast.Creative) *Creative {
+	p := &Creative{
+		Id:                 c.ID,
+		Sequence:           int64(c.Sequence),
+		AdId:               c.AdID,
+		ApiFramework:       c.APIFramework,
+		Linear:             fromLinear(c.Linear),
+		CreativeExtensions: fromExtensionList(c.CreativeExtensions),
+	}
+	if c.UniversalAdID != nil {
+		p.UniversalAdId = &UniversalAdID{IdRegistry: c.UniversalAdID.IDRegistry, Id: c.UniversalAdID.ID}
+	}
+	if c.CompanionAds != nil {
+		p.CompanionAds = &CompanionAds{Required: string(c.CompanionAds.Required)}
+		for i := range c.CompanionAds.Companions {
+			p.CompanionAds.Companions = append(p.CompanionAds.Companions, fromCompanion(&c.CompanionAds.Companions[i]))
+		}
+	}
+	if c.NonLinearAds != nil {
+		p.NonLinearAds = &NonLinearAds{TrackingEvents: fromTrackings(c.NonLinearAds.TrackingEvents)}
+		for i := range c.NonLinearAds.NonLinears {
+			p.NonLinearAds.NonLinears = append(p.NonLinearAds.NonLinears, fromNonLinear(&c.NonLinearAds.NonLinears[i]))
+		}
+	}
+	return p
+}
+
+func toCreative(p *Creative) vast.Creative {
+	c := vast.Creative{
+		ID:                 p.Id,
+		Sequence:           int(p.Sequence),
+		AdID:               p.AdId,
+		APIFramework:       p.ApiFramework,
+		Linear:             toLinear(p.Linear),
+		CreativeExtensions: toExtensionList(p.CreativeExtensions),
+	}
+	if p.UniversalAdId != nil {
+		c.UniversalAdID = &vast.UniversalAdID{IDRegistry: p.UniversalAdId.IdRegistry, ID: p.UniversalAdId.Id}
+	}
+	if p.CompanionAds != nil {
+		c.CompanionAds = &vast.CompanionAds{Required: vast.CompanionsRequired(p.CompanionAds.Required)}
+		for _, comp := range p.CompanionAds.Companions {
+			c.CompanionAds.Companions = append(c.CompanionAds.Companions, toCompanion(comp))
+		}
+	}
+	if p.NonLinearAds != nil {
+		c.NonLinearAds = &vast.NonLinearAds{TrackingEvents: toTrackings(p.NonLinearAds.TrackingEvents)}
+		for _, nl := range p.NonLinearAds.NonLinears {
+			c.NonLinearAds.NonLinears = append(c.NonLinearAds.NonLinears, toNonLinear(nl))
+		}
+	}
+	return c
+}
+
+func fromCreativeWrapper(c *vast.CreativeWrapper) *CreativeWrapper {
+	p := &CreativeWrapper{Id: c.ID, Sequence: int64(c.Sequence), AdId: c.AdID}
+	if c.Linear != nil {
+		p.Linear = &LinearWrapper{
+			Icons:          fromIcons(c.Linear.Icons),
+			TrackingEvents: fromTrackings(c.Linear.TrackingEvents),
+			VideoClicks:    fromVideoClicks(c.Linear.VideoClicks),
+		}
+	}
+	if c.CompanionAds != nil {
+		p.CompanionAds = &CompanionAdsWrapper{Required: string(c.CompanionAds.Required)}
+		for i := range c.CompanionAds.Companions {
+			p.CompanionAds.Companions = append(p.CompanionAds.Companions, fromCompanionWrapper(&c.CompanionAds.Companions[i]))
+		}
+	}
+	if c.NonLinearAds != nil {
+		p.NonLinearAds = &NonLinearAdsWrapper{TrackingEvents: fromTrackings(c.NonLinearAds.TrackingEvents)}
+		for i := range c.NonLinearAds.NonLinears {
+			p.NonLinearAds.NonLinears = append(p.NonLinearAds.NonLinears, fromNonLinearWrapper(&c.NonLinearAds.NonLinears[i]))
+		}
+	}
+	return p
+}
+
+func toCreativeWrapper(p *CreativeWrapper) vast.CreativeWrapper {
+	c := vast.CreativeWrapper{ID: p.Id, Sequence: int(p.Sequence), AdID: p.AdId}
+	if p.Linear != nil {
+		c.Linear = &vast.LinearWrapper{
+			Icons:          toIcons(p.Linear.Icons),
+			TrackingEvents: toTrackings(p.Linear.TrackingEvents),
+			VideoClicks:    toVideoClicks(p.Linear.VideoClicks),
+		}
+	}
+	if p.CompanionAds != nil {
+		c.CompanionAds = &vast.CompanionAdsWrapper{Required: vast.CompanionsRequired(p.CompanionAds.Required)}
+		for _, comp := range p.CompanionAds.Companions {
+			c.CompanionAds.Companions = append(c.CompanionAds.Companions, toCompanionWrapper(comp))
+		}
+	}
+	if p.NonLinearAds != nil {
+		c.NonLinearAds = &vast.NonLinearAdsWrapper{TrackingEvents: toTrackings(p.NonLinearAds.TrackingEvents)}
+		for _, nl := range p.NonLinearAds.NonLinears {
+			c.NonLinearAds.NonLinears = append(c.NonLinearAds.NonLinears, toNonLinearWrapper(nl))
+		}
+	}
+	return c
+}
+
+func fromLinear(l *vast.Linear) *Linear {
+	if l == nil {
+		return nil
+	}
+	p := &Linear{
+		SkipOffset:     fromOffset(l.SkipOffset),
+		Icons:          fromIcons(l.Icons),
+		TrackingEvents: fromTrackings(l.TrackingEvents),
+		AdParameters:   fromAdParameters(l.AdParameters),
+		Duration:       int64(l.Duration),
+		VideoClicks:    fromVideoClicks(l.VideoClicks),
+	}
+	for _, mf := range l.MediaFiles {
+		p.MediaFiles = append(p.MediaFiles, &MediaFile{
+			Id:                  mf.ID,
+			Delivery:            mf.Delivery,
+			Type:                mf.Type,
+			Codec:               mf.Codec,
+			Bitrate:             int64(mf.Bitrate),
+			MinBitrate:          int64(mf.MinBitrate),
+			MaxBitrate:          int64(mf.MaxBitrate),
+			Width:               int64(mf.Width),
+			Height:              int64(mf.Height),
+			Scalable:            mf.Scalable,
+			MaintainAspectRatio: mf.MaintainAspectRatio,
+			ApiFramework:        mf.APIFramework,
+			Uri:                 mf.URI,
+			FileSize:            int64(mf.FileSize),
+			MediaType:           mf.MediaType,
+		})
+	}
+	return p
+}
+
+func toLinear(p *Linear) *vast.Linear {
+	if p == nil {
+		return nil
+	}
+	l := &vast.Linear{
+		SkipOffset:     toOffset(p.SkipOffset),
+		Icons:          toIcons(p.Icons),
+		TrackingEvents: toTrackings(p.TrackingEvents),
+		AdParameters:   toAdParameters(p.AdParameters),
+		Duration:       vast.Duration(p.Duration),
+		VideoClicks:    toVideoClicks(p.VideoClicks),
+	}
+	for _, mf := range p.MediaFiles {
+		l.MediaFiles = append(l.MediaFiles, vast.MediaFile{
+			ID:                  mf.Id,
+			Delivery:            mf.Delivery,
+			Type:                mf.Type,
+			Codec:               mf.Codec,
+			Bitrate:             int(mf.Bitrate),
+			MinBitrate:          int(mf.MinBitrate),
+			MaxBitrate:          int(mf.MaxBitrate),
+			Width:               int(mf.Width),
+			Height:              int(mf.Height),
+			Scalable:            mf.Scalable,
+			MaintainAspectRatio: mf.MaintainAspectRatio,
+			APIFramework:        mf.ApiFramework,
+			URI:                 mf.Uri,
+			FileSize:            int(mf.FileSize),
+			MediaType:           mf.MediaType,
+		})
+	}
+	return l
+}
+
+func fromCompanion(c *vast.Companion) *Companion {
+	return &Companion{
+		Id:                      c.ID,
+		Width:                   int64(c.Width),
+		Height:                  int64(c.Height),
+		AssetWidth:              int64(c.AssetWidth),
+		AssetHeight:             int64(c.AssetHeight),
+		ExpandedWidth:           int64(c.ExpandedWidth),
+		ExpandedHeight:          int64(c.ExpandedHeight),
+		ApiFramework:            c.APIFramework,
+		AdSlotId:                c.AdSlotID,
+		PxRatio:                 c.PxRatio,
+		RenderingMode:           string(c.RenderingMode),
+		HtmlResource:            fromHTMLResource(c.HTMLResource),
+		IframeResource:          fromOptCDATA(c.IFrameResource),
+		StaticResource:          fromStaticResource(c.StaticResource),
+		AdParameters:            fromAdParameters(c.AdParameters),
+		AltText:                 c.AltText,
+		CompanionClickThrough:   fromOptCDATA(c.CompanionClickThrough),
+		CompanionClickTrackings: fromCompanionClickTrackings(c.CompanionClickTrackings),
+		TrackingEvents:          fromTrackings(c.TrackingEvents),
+		CreativeExtensions:      fromExtensionList(c.CreativeExtensions),
+	}
+}
+
+func toCompanion(p *Companion) vast.Companion {
+	return vast.Companion{
+		ID:                      p.Id,
+		Width:                   int(p.Width),
+		Height:                  int(p.Height),
+		AssetWidth:              int(p.AssetWidth),
+		AssetHeight:             int(p.AssetHeight),
+		ExpandedWidth:           int(p.ExpandedWidth),
+		ExpandedHeight:          int(p.ExpandedHeight),
+		APIFramework:            p.ApiFramework,
+		AdSlotID:                p.AdSlotId,
+		PxRatio:                 p.PxRatio,
+		RenderingMode:           vast.RenderingMode(p.RenderingMode),
+		HTMLResource:            toHTMLResource(p.HtmlResource),
+		IFrameResource:          toOptCDATA(p.IframeResource),
+		StaticResource:          toStaticResource(p.StaticResource),
+		AdParameters:            toAdParameters(p.AdParameters),
+		AltText:                 p.AltText,
+		CompanionClickThrough:   toOptCDATA(p.CompanionClickThrough),
+		CompanionClickTrackings: toCompanionClickTrackings(p.CompanionClickTrackings),
+		TrackingEvents:          toTrackings(p.TrackingEvents),
+		CreativeExtensions:      toExtensionList(p.CreativeExtensions),
+	}
+}
+
+func fromCompanionWrapper(c *vast.CompanionWrapper) *CompanionWrapper {
+	return &CompanionWrapper{
+		Id:                     c.ID,
+		Width:                  int64(c.Width),
+		Height:                 int64(c.Height),
+		AssetWidth:             int64(c.AssetWidth),
+		AssetHeight:            int64(c.AssetHeight),
+		ExpandedWidth:          int64(c.ExpandedWidth),
+		ExpandedHeight:         int64(c.ExpandedHeight),
+		ApiFramework:           c.APIFramework,
+		AdSlotId:               c.AdSlotID,
+		PxRatio:                c.PxRatio,
+		RenderingMode:          string(c.RenderingMode),
+		CompanionClickThrough:  fromOptCDATA(c.CompanionClickThrough),
+		CompanionClickTracking: fromCompanionClickTrackings(c.CompanionClickTracking),
+		AltText:                c.AltText,
+		TrackingEvents:         fromTrackings(c.TrackingEvents),
+		AdParameters:           fromAdParameters(c.AdParameters),
+		StaticResource:         fromStaticResource(c.StaticResource),
+		IframeResource:         fromOptCDATA(c.IFrameResource),
+		HtmlResource:           fromHTMLResource(c.HTMLResource),
+		CreativeExtensions:     fromExtensionList(c.CreativeExtensions),
+	}
+}
+
+func toCompanionWrapper(p *CompanionWrapper) vast.CompanionWrapper {
+	return vast.CompanionWrapper{
+		ID:                     p.Id,
+		Width:                  int(p.Width),
+		Height:                 int(p.Height),
+		AssetWidth:             int(p.AssetWidth),
+		AssetHeight:            int(p.AssetHeight),
+		ExpandedWidth:          int(p.ExpandedWidth),
+		ExpandedHeight:         int(p.ExpandedHeight),
+		APIFramework:           p.ApiFramework,
+		AdSlotID:               p.AdSlotId,
+		PxRatio:                p.PxRatio,
+		RenderingMode:          vast.RenderingMode(p.RenderingMode),
+		CompanionClickThrough:  toOptCDATA(p.CompanionClickThrough),
+		CompanionClickTracking: toCompanionClickTrackings(p.CompanionClickTracking),
+		AltText:                p.AltText,
+		TrackingEvents:         toTrackings(p.TrackingEvents),
+		AdParameters:           toAdParameters(p.AdParameters),
+		StaticResource:         toStaticResource(p.StaticResource),
+		IFrameResource:         toOptCDATA(p.IframeResource),
+		HTMLResource:           toHTMLResource(p.HtmlResource),
+		CreativeExtensions:     toExtensionList(p.CreativeExtensions),
+	}
+}
+
+func fromNonLinear(nl *vast.NonLinear) *NonLinear {
+	p := &NonLinear{
+		Id:                    nl.ID,
+		Width:                 int64(nl.Width),
+		Height:                int64(nl.Height),
+		ExpandedWidth:         int64(nl.ExpandedWidth),
+		ExpandedHeight:        int64(nl.ExpandedHeight),
+		Scalable:              nl.Scalable,
+		MaintainAspectRatio:   nl.MaintainAspectRatio,
+		MinSuggestedDuration:  fromOptDuration(nl.MinSuggestedDuration),
+		ApiFramework:          nl.APIFramework,
+		HtmlResource:          fromHTMLResource(nl.HTMLResource),
+		IframeResource:        fromOptCDATA(nl.IFrameResource),
+		StaticResource:        fromStaticResource(nl.StaticResource),
+		AdParameters:          fromAdParameters(nl.AdParameters),
+		NonLinearClickThrough: fromOptCDATA(nl.NonLinearClickThrough),
+	}
+	for _, t := range nl.NonLinearClickTrackings {
+		p.NonLinearClickTrackings = append(p.NonLinearClickTrackings, &ClickTracking{Id: t.ID, Uri: t.URI})
+	}
+	return p
+}
+
+func toNonLinear(p *NonLinear) vast.NonLinear {
+	nl := vast.NonLinear{
+		ID:                    p.Id,
+		Width:                 int(p.Width),
+		Height:                int(p.Height),
+		ExpandedWidth:         int(p.ExpandedWidth),
+		ExpandedHeight:        int(p.ExpandedHeight),
+		Scalable:              p.Scalable,
+		MaintainAspectRatio:   p.MaintainAspectRatio,
+		MinSuggestedDuration:  toOptDuration(p.MinSuggestedDuration),
+		APIFramework:          p.ApiFramework,
+		HTMLResource:          toHTMLResource(p.HtmlResource),
+		IFrameResource:        toOptCDATA(p.IframeResource),
+		StaticResource:        toStaticResource(p.StaticResource),
+		AdParameters:          toAdParameters(p.AdParameters),
+		NonLinearClickThrough: toOptCDATA(p.NonLinearClickThrough),
+	}
+	for _, t := range p.NonLinearClickTrackings {
+		nl.NonLinearClickTrackings = append(nl.NonLinearClickTrackings, vast.NonLinearClickTracking{ID: t.Id, URI: t.Uri})
+	}
+	return nl
+}
+
+func fromNonLinearWrapper(nl *vast.NonLinearWrapper) *NonLinearWrapper {
+	return &NonLinearWrapper{
+		Id:                     nl.ID,
+		Width:                  int64(nl.Width),
+		Height:                 int64(nl.Height),
+		ExpandedWidth:          int64(nl.ExpandedWidth),
+		ExpandedHeight:         int64(nl.ExpandedHeight),
+		Scalable:               nl.Scalable,
+		MaintainAspectRatio:    nl.MaintainAspectRatio,
+		MinSuggestedDuration:   fromOptDuration(nl.MinSuggestedDuration),
+		ApiFramework:           nl.APIFramework,
+		TrackingEvents:         fromTrackings(nl.TrackingEvents),
+		NonLinearClickTracking: fromCDATAs(nl.NonLinearClickTracking),
+	}
+}
+
+func toNonLinearWrapper(p *NonLinearWrapper) vast.NonLinearWrapper {
+	return vast.NonLinearWrapper{
+		ID:                     p.Id,
+		Width:                  int(p.Width),
+		Height:                 int(p.Height),
+		ExpandedWidth:          int(p.ExpandedWidth),
+		ExpandedHeight:         int(p.ExpandedHeight),
+		Scalable:               p.Scalable,
+		MaintainAspectRatio:    p.MaintainAspectRatio,
+		MinSuggestedDuration:   toOptDuration(p.MinSuggestedDuration),
+		APIFramework:           p.ApiFramework,
+		TrackingEvents:         toTrackings(p.TrackingEvents),
+		NonLinearClickTracking: toCDATAs(p.NonLinearClickTracking),
+	}
+}
+
+func fromIcons(icons *vast.Icons) *Icons {
+	if icons == nil {
+		return nil
+	}
+	p := &Icons{}
+	for _, icon := range icons.Icon {
+		pi := &Icon{
+			Program:           icon.Program,
+			Width:             int64(icon.Width),
+			Height:            int64(icon.Height),
+			XPosition:         icon.XPosition,
+			YPosition:         icon.YPosition,
+			Offset:            fromOffset(icon.Offset),
+			Duration:          fromOptDuration(icon.Duration),
+			ApiFramework:      icon.APIFramework,
+			PxRatio:           icon.PxRatio,
+			AltText:           icon.AltText,
+			HoverText:         icon.HoverText,
+			HtmlResource:      fromHTMLResource(icon.HTMLResource),
+			IframeResource:    fromOptCDATA(icon.IFrameResource),
+			StaticResource:    fromStaticResource(icon.StaticResource),
+			IconViewTrackings: fromCDATAs(icon.IconViewTrackings),
+		}
+		if c := icon.IconClicks; c != nil {
+			pi.IconClicks = &IconClicks{IconClickThrough: fromOptCDATA(c.IconClickThrough)}
+			for _, t := range c.IconClickTrackings {
+				pi.IconClicks.IconClickTrackings = append(pi.IconClicks.IconClickTrackings, &ClickTracking{Id: t.ID, Uri: t.URI})
+			}
+			if c.IconClickFallbackImages != nil {
+				pi.IconClicks.IconClickFallbackImages = &IconClickFallbackImages{}
+				for _, img := range c.IconClickFallbackImages.IconClickFallbackImage {
+					pi.IconClicks.IconClickFallbackImages.IconClickFallbackImages = append(pi.IconClicks.IconClickFallbackImages.IconClickFallbackImages, &IconClickFallbackImage{
+						Width:          int64(img.Width),
+						Height:         int64(img.Height),
+						AltText:        img.AltText,
+						StaticResource: fromStaticResource(img.StaticResource),
+					})
+				}
+			}
+		}
+		p.Icons = append(p.Icons, pi)
+	}
+	return p
+}
+
+func toIcons(p *Icons) *vast.Icons {
+	if p == nil {
+		return nil
+	}
+	icons := &vast.Icons{}
+	for _, pi := range p.Icons {
+		icon := vast.Icon{
+			Program:           pi.Program,
+			Width:             int(pi.Width),
+			Height:            int(pi.Height),
+			XPosition:         pi.XPosition,
+			YPosition:         pi.YPosition,
+			Offset:            toOffset(pi.Offset),
+			Duration:          toOptDuration(pi.Duration),
+			APIFramework:      pi.ApiFramework,
+			PxRatio:           pi.PxRatio,
+			AltText:           pi.AltText,
+			HoverText:         pi.HoverText,
+			HTMLResource:      toHTMLResource(pi.HtmlResource),
+			IFrameResource:    toOptCDATA(pi.IframeResource),
+			StaticResource:    toStaticResource(pi.StaticResource),
+			IconViewTrackings: toCDATAs(pi.IconViewTrackings),
+		}
+		if c := pi.IconClicks; c != nil {
+			icon.IconClicks = &vast.IconClicks{IconClickThrough: toOptCDATA(c.IconClickThrough)}
+			for _, t := range c.IconClickTrackings {
+				icon.IconClicks.IconClickTrackings = append(icon.IconClicks.IconClickTrackings, vast.IconClickTracking{ID: t.Id, URI: t.Uri})
+			}
+			if c.IconClickFallbackImages != nil {
+				icon.IconClicks.IconClickFallbackImages = &vast.IconClickFallbackImages{}
+				for _, img := range c.IconClickFallbackImages.IconClickFallbackImages {
+					icon.IconClicks.IconClickFallbackImages.IconClickFallbackImage = append(icon.IconClicks.IconClickFallbackImages.IconClickFallbackImage, vast.IconClickFallbackImage{
+						Width:          int(img.Width),
+						Height:         int(img.Height),
+						AltText:        img.AltText,
+						StaticResource: toStaticResource(img.StaticResource),
+					})
+				}
+			}
+		}
+		icons.Icon = append(icons.Icon, icon)
+	}
+	return icons
+}
+
+func fromTrackings(ts []vast.Tracking) []*Tracking {
+	var res []*Tracking
+	for _, t := range ts {
+		res = append(res, &Tracking{Event: string(t.Event), Offset: fromOffset(t.Offset), Uri: t.URI, Ua: t.UA})
+	}
+	return res
+}
+
+func toTrackings(ps []*Tracking) []vast.Tracking {
+	var res []vast.Tracking
+	for _, p := range ps {
+		res = append(res, vast.Tracking{Event: vast.EventType(p.Event), Offset: toOffset(p.Offset), URI: p.Uri, UA: p.Ua})
+	}
+	return res
+}
+
+func fromOffset(o *vast.Offset) *Offset {
+	if o == nil {
+		return nil
+	}
+	if o.Duration != nil {
+		return &Offset{Value: &Offset_Duration{Duration: int64(*o.Duration)}}
+	}
+	return &Offset{Value: &Offset_Percent{Percent: o.Percent}}
+}
+
+func toOffset(p *Offset) *vast.Offset {
+	if p == nil {
+		return nil
+	}
+	if d, ok := p.Value.(*Offset_Duration); ok {
+		dur := vast.Duration(d.Duration)
+		return &vast.Offset{Duration: &dur}
+	}
+	return &vast.Offset{Percent: p.GetPercent()}
+}
+
+func fromOptDuration(d *vast.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	n := int64(*d)
+	return &n
+}
+
+func toOptDuration(n *int64) *vast.Duration {
+	if n == nil {
+		return nil
+	}
+	d := vast.Duration(time.Duration(*n))
+	return &d
+}
+
+func fromVideoClicks(c *vast.VideoClicks) *VideoClicks {
+	if c == nil {
+		return nil
+	}
+	return &VideoClicks{
+		ClickTrackings: fromVideoClickList(c.ClickTrackings),
+		CustomClicks:   fromVideoClickList(c.CustomClicks),
+		ClickThroughs:  fromVideoClickList(c.ClickThroughs),
+	}
+}
+
+func toVideoClicks(p *VideoClicks) *vast.VideoClicks {
+	if p == nil {
+		return nil
+	}
+	return &vast.VideoClicks{
+		ClickTrackings: toVideoClickList(p.ClickTrackings),
+		CustomClicks:   toVideoClickList(p.CustomClicks),
+		ClickThroughs:  toVideoClickList(p.ClickThroughs),
+	}
+}
+
+func fromVideoClickList(clicks []vast.VideoClick) []*ClickTracking {
+	var res []*ClickTracking
+	for _, c := range clicks {
+		res = append(res, &ClickTracking{Id: c.ID, Uri: c.URI})
+	}
+	return res
+}
+
+func toVideoClickList(ps []*ClickTracking) []vast.VideoClick {
+	var res []vast.VideoClick
+	for _, p := range ps {
+		res = append(res, vast.VideoClick{ID: p.Id, URI: p.Uri})
+	}
+	return res
+}
+
+func fromCompanionClickTrackings(ts []vast.CompanionClickTracking) []*ClickTracking {
+	var res []*ClickTracking
+	for _, t := range ts {
+		res = append(res, &ClickTracking{Id: t.ID, Uri: t.URI})
+	}
+	return res
+}
+
+func toCompanionClickTrackings(ps []*ClickTracking) []vast.CompanionClickTracking {
+	var res []vast.CompanionClickTracking
+	for _, p := range ps {
+		res = append(res, vast.CompanionClickTracking{ID: p.Id, URI: p.Uri})
+	}
+	return res
+}
+
+func fromHTMLResource(r *vast.HTMLResource) *HTMLResource {
+	if r == nil {
+		return nil
+	}
+	return &HTMLResource{XmlEncoded: r.XMLEncoded, Html: r.HTML}
+}
+
+func toHTMLResource(p *HTMLResource) *vast.HTMLResource {
+	if p == nil {
+		return nil
+	}
+	return &vast.HTMLResource{XMLEncoded: p.XmlEncoded, HTML: p.Html}
+}
+
+func fromStaticResource(r *vast.StaticResource) *StaticResource {
+	if r == nil {
+		return nil
+	}
+	return &StaticResource{CreativeType: r.CreativeType, Uri: r.URI}
+}
+
+func toStaticResource(p *StaticResource) *vast.StaticResource {
+	if p == nil {
+		return nil
+	}
+	return &vast.StaticResource{CreativeType: p.CreativeType, URI: p.Uri}
+}
+
+func fromAdParameters(a *vast.AdParameters) *AdParameters {
+	if a == nil {
+		return nil
+	}
+	return &AdParameters{XmlEncoded: a.XMLEncoded, Parameters: a.Parameters}
+}
+
+func toAdParameters(p *AdParameters) *vast.AdParameters {
+	if p == nil {
+		return nil
+	}
+	return &vast.AdParameters{XMLEncoded: p.XmlEncoded, Parameters: p.Parameters}
+}
+
+func fromCDATAs(cs []vast.CDATAString) []string {
+	var res []string
+	for _, c := range cs {
+		res = append(res, c.CDATA)
+	}
+	return res
+}
+
+func toCDATAs(ss []string) []vast.CDATAString {
+	var res []vast.CDATAString
+	for _, s := range ss {
+		res = append(res, vast.CDATAString{CDATA: s})
+	}
+	return res
+}
+
+func fromOptCDATA(c *vast.CDATAString) *string {
+	if c == nil {
+		return nil
+	}
+	s := c.CDATA
+	return &s
+}
+
+func toOptCDATA(s *string) *vast.CDATAString {
+	if s == nil {
+		return nil
+	}
+	return &vast.CDATAString{CDATA: *s}
+}
@@ -0,0 +1,83 @@
+package vastpb
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vast "github.com/zattoo/go-vast"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRoundTrip(t *testing.T) {
+	files, err := filepath.Glob("../testdata/*.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".xml"), func(t *testing.T) {
+			b, err := ioutil.ReadFile(file)
+			if !assert.NoError(t, err) {
+				return
+			}
+			var v vast.VAST
+			if err := vast.Unmarshal(b, &v); err != nil {
+				t.Skipf("invalid document: %v", err)
+			}
+
+			data, err := proto.Marshal(FromVAST(&v))
+			if !assert.NoError(t, err) {
+				return
+			}
+			var p VAST
+			if !assert.NoError(t, proto.Unmarshal(data, &p)) {
+				return
+			}
+			got := ToVAST(&p)
+			assert.Equal(t, &v, got)
+
+			x1, _ := xml.Marshal(v)
+			x2, _ := xml.Marshal(got)
+			assert.Equal(t, string(x1), string(x2))
+		})
+	}
+}
+
+func TestConvertPointers(t *testing.T) {
+	yes := true
+	d := vast.Duration(1500 * time.Millisecond)
+	v := &vast.VAST{
+		Version: "4.2",
+		Ads: []vast.Ad{{
+			Wrapper: &vast.Wrapper{
+				VASTAdTagURI:   vast.CDATAString{CDATA: "http://example.com/tag"},
+				FallbackOnNoAd: &yes,
+				Creatives: []vast.CreativeWrapper{{
+					Linear: &vast.LinearWrapper{
+						TrackingEvents: vast.TrackingEvents{
+							{Event: vast.Event_type_progress, Offset: &vast.Offset{Duration: &d}, URI: "http://example.com/progress"},
+							{Event: vast.Event_type_start, Offset: &vast.Offset{}, URI: "http://example.com/start"},
+						},
+					},
+				}},
+			},
+		}, {
+			InLine: &vast.InLine{
+				Extensions:  &[]vast.Extension{},
+				Description: &vast.CDATAString{},
+			},
+		}},
+	}
+	p := FromVAST(v)
+	assert.True(t, p.Ads[0].Wrapper.GetFallbackOnNoAd())
+	assert.Nil(t, p.Ads[0].Wrapper.AllowMultipleAds)
+	assert.Equal(t, int64(1500*time.Millisecond), p.Ads[0].Wrapper.Creatives[0].Linear.TrackingEvents[0].Offset.GetDuration())
+	assert.Equal(t, v, ToVAST(p))
+
+	assert.Nil(t, FromVAST(nil))
+	assert.Nil(t, ToVAST(nil))
+}
@@ -0,0 +1,4246 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: vastpb/vast.proto
+
+package vastpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VAST struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Xmlns   string   `protobuf:"bytes,2,opt,name=xmlns,proto3" json:"xmlns,omitempty"`
+	Ads     []*Ad    `protobuf:"bytes,3,rep,name=ads,proto3" json:"ads,omitempty"`
+	Errors  []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	Mute    bool     `protobuf:"varint,5,opt,name=mute,proto3" json:"mute,omitempty"`
+}
+
+func (x *VAST) Reset() {
+	*x = VAST{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VAST) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VAST) ProtoMessage() {}
+
+func (x *VAST) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VAST.ProtoReflect.Descriptor instead.
+func (*VAST) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VAST) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VAST) GetXmlns() string {
+	if x != nil {
+		return x.Xmlns
+	}
+	return ""
+}
+
+func (x *VAST) GetAds() []*Ad {
+	if x != nil {
+		return x.Ads
+	}
+	return nil
+}
+
+func (x *VAST) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *VAST) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+type Ad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InLine   *InLine  `protobuf:"bytes,1,opt,name=in_line,json=inLine,proto3" json:"in_line,omitempty"`
+	Wrapper  *Wrapper `protobuf:"bytes,2,opt,name=wrapper,proto3" json:"wrapper,omitempty"`
+	Id       string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Sequence int64    `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	AdType   string   `protobuf:"bytes,5,opt,name=ad_type,json=adType,proto3" json:"ad_type,omitempty"`
+}
+
+func (x *Ad) Reset() {
+	*x = Ad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ad) ProtoMessage() {}
+
+func (x *Ad) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ad.ProtoReflect.Descriptor instead.
+func (*Ad) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Ad) GetInLine() *InLine {
+	if x != nil {
+		return x.InLine
+	}
+	return nil
+}
+
+func (x *Ad) GetWrapper() *Wrapper {
+	if x != nil {
+		return x.Wrapper
+	}
+	return nil
+}
+
+func (x *Ad) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ad) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Ad) GetAdType() string {
+	if x != nil {
+		return x.AdType
+	}
+	return ""
+}
+
+type InLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdSystem    *AdSystem     `protobuf:"bytes,1,opt,name=ad_system,json=adSystem,proto3" json:"ad_system,omitempty"`
+	Errors      []string      `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Extensions  *Extensions   `protobuf:"bytes,3,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	Impressions []*Impression `protobuf:"bytes,4,rep,name=impressions,proto3" json:"impressions,omitempty"`
+	Pricing     *Pricing      `protobuf:"bytes,5,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	AdServingId string        `protobuf:"bytes,6,opt,name=ad_serving_id,json=adServingId,proto3" json:"ad_serving_id,omitempty"`
+	AdTitle     string        `protobuf:"bytes,7,opt,name=ad_title,json=adTitle,proto3" json:"ad_title,omitempty"`
+	Advertiser  string        `protobuf:"bytes,8,opt,name=advertiser,proto3" json:"advertiser,omitempty"`
+	Creatives   []*Creative   `protobuf:"bytes,9,rep,name=creatives,proto3" json:"creatives,omitempty"`
+	Description *string       `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Survey      *string       `protobuf:"bytes,11,opt,name=survey,proto3,oneof" json:"survey,omitempty"`
+	Expires     int64         `protobuf:"varint,12,opt,name=expires,proto3" json:"expires,omitempty"`
+	Categories  []*Category   `protobuf:"bytes,13,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *InLine) Reset() {
+	*x = InLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InLine) ProtoMessage() {}
+
+func (x *InLine) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InLine.ProtoReflect.Descriptor instead.
+func (*InLine) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InLine) GetAdSystem() *AdSystem {
+	if x != nil {
+		return x.AdSystem
+	}
+	return nil
+}
+
+func (x *InLine) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *InLine) GetExtensions() *Extensions {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *InLine) GetImpressions() []*Impression {
+	if x != nil {
+		return x.Impressions
+	}
+	return nil
+}
+
+func (x *InLine) GetPricing() *Pricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+func (x *InLine) GetAdServingId() string {
+	if x != nil {
+		return x.AdServingId
+	}
+	return ""
+}
+
+func (x *InLine) GetAdTitle() string {
+	if x != nil {
+		return x.AdTitle
+	}
+	return ""
+}
+
+func (x *InLine) GetAdvertiser() string {
+	if x != nil {
+		return x.Advertiser
+	}
+	return ""
+}
+
+func (x *InLine) GetCreatives() []*Creative {
+	if x != nil {
+		return x.Creatives
+	}
+	return nil
+}
+
+func (x *InLine) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *InLine) GetSurvey() string {
+	if x != nil && x.Survey != nil {
+		return *x.Survey
+	}
+	return ""
+}
+
+func (x *InLine) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *InLine) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type Wrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdSystem                 *AdSystem          `protobuf:"bytes,1,opt,name=ad_system,json=adSystem,proto3" json:"ad_system,omitempty"`
+	Errors                   []string           `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Extensions               []*Extension       `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	Impressions              []*Impression      `protobuf:"bytes,4,rep,name=impressions,proto3" json:"impressions,omitempty"`
+	Creatives                []*CreativeWrapper `protobuf:"bytes,5,rep,name=creatives,proto3" json:"creatives,omitempty"`
+	VastAdTagUri             string             `protobuf:"bytes,6,opt,name=vast_ad_tag_uri,json=vastAdTagUri,proto3" json:"vast_ad_tag_uri,omitempty"`
+	FallbackOnNoAd           *bool              `protobuf:"varint,7,opt,name=fallback_on_no_ad,json=fallbackOnNoAd,proto3,oneof" json:"fallback_on_no_ad,omitempty"`
+	AllowMultipleAds         *bool              `protobuf:"varint,8,opt,name=allow_multiple_ads,json=allowMultipleAds,proto3,oneof" json:"allow_multiple_ads,omitempty"`
+	FollowAdditionalWrappers *bool              `protobuf:"varint,9,opt,name=follow_additional_wrappers,json=followAdditionalWrappers,proto3,oneof" json:"follow_additional_wrappers,omitempty"`
+	Pricing                  *Pricing           `protobuf:"bytes,10,opt,name=pricing,proto3" json:"pricing,omitempty"`
+}
+
+func (x *Wrapper) Reset() {
+	*x = Wrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Wrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wrapper) ProtoMessage() {}
+
+func (x *Wrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wrapper.ProtoReflect.Descriptor instead.
+func (*Wrapper) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Wrapper) GetAdSystem() *AdSystem {
+	if x != nil {
+		return x.AdSystem
+	}
+	return nil
+}
+
+func (x *Wrapper) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *Wrapper) GetExtensions() []*Extension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *Wrapper) GetImpressions() []*Impression {
+	if x != nil {
+		return x.Impressions
+	}
+	return nil
+}
+
+func (x *Wrapper) GetCreatives() []*CreativeWrapper {
+	if x != nil {
+		return x.Creatives
+	}
+	return nil
+}
+
+func (x *Wrapper) GetVastAdTagUri() string {
+	if x != nil {
+		return x.VastAdTagUri
+	}
+	return ""
+}
+
+func (x *Wrapper) GetFallbackOnNoAd() bool {
+	if x != nil && x.FallbackOnNoAd != nil {
+		return *x.FallbackOnNoAd
+	}
+	return false
+}
+
+func (x *Wrapper) GetAllowMultipleAds() bool {
+	if x != nil && x.AllowMultipleAds != nil {
+		return *x.AllowMultipleAds
+	}
+	return false
+}
+
+func (x *Wrapper) GetFollowAdditionalWrappers() bool {
+	if x != nil && x.FollowAdditionalWrappers != nil {
+		return *x.FollowAdditionalWrappers
+	}
+	return false
+}
+
+func (x *Wrapper) GetPricing() *Pricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+type AdSystem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *AdSystem) Reset() {
+	*x = AdSystem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdSystem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdSystem) ProtoMessage() {}
+
+func (x *AdSystem) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdSystem.ProtoReflect.Descriptor instead.
+func (*AdSystem) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AdSystem) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AdSystem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Impression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *Impression) Reset() {
+	*x = Impression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Impression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Impression) ProtoMessage() {}
+
+func (x *Impression) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Impression.ProtoReflect.Descriptor instead.
+func (*Impression) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Impression) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Impression) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type Pricing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model    string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Pricing) Reset() {
+	*x = Pricing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pricing) ProtoMessage() {}
+
+func (x *Pricing) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pricing.ProtoReflect.Descriptor instead.
+func (*Pricing) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Pricing) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Pricing) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Pricing) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Category struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Category) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *Category) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type Extensions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extensions []*Extension `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+}
+
+func (x *Extensions) Reset() {
+	*x = Extensions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extensions) ProtoMessage() {}
+
+func (x *Extensions) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extensions.ProtoReflect.Descriptor instead.
+func (*Extensions) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Extensions) GetExtensions() []*Extension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type Extension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type           string      `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	CustomTracking []*Tracking `protobuf:"bytes,2,rep,name=custom_tracking,json=customTracking,proto3" json:"custom_tracking,omitempty"`
+	Data           string      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Extension) Reset() {
+	*x = Extension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extension) ProtoMessage() {}
+
+func (x *Extension) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extension.ProtoReflect.Descriptor instead.
+func (*Extension) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Extension) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Extension) GetCustomTracking() []*Tracking {
+	if x != nil {
+		return x.CustomTracking
+	}
+	return nil
+}
+
+func (x *Extension) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type Creative struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sequence           int64          `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	AdId               string         `protobuf:"bytes,3,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	ApiFramework       string         `protobuf:"bytes,4,opt,name=api_framework,json=apiFramework,proto3" json:"api_framework,omitempty"`
+	UniversalAdId      *UniversalAdID `protobuf:"bytes,5,opt,name=universal_ad_id,json=universalAdId,proto3" json:"universal_ad_id,omitempty"`
+	Linear             *Linear        `protobuf:"bytes,6,opt,name=linear,proto3" json:"linear,omitempty"`
+	CompanionAds       *CompanionAds  `protobuf:"bytes,7,opt,name=companion_ads,json=companionAds,proto3" json:"companion_ads,omitempty"`
+	NonLinearAds       *NonLinearAds  `protobuf:"bytes,8,opt,name=non_linear_ads,json=nonLinearAds,proto3" json:"non_linear_ads,omitempty"`
+	CreativeExtensions *Extensions    `protobuf:"bytes,9,opt,name=creative_extensions,json=creativeExtensions,proto3" json:"creative_extensions,omitempty"`
+}
+
+func (x *Creative) Reset() {
+	*x = Creative{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Creative) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Creative) ProtoMessage() {}
+
+func (x *Creative) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Creative.ProtoReflect.Descriptor instead.
+func (*Creative) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Creative) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Creative) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Creative) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *Creative) GetApiFramework() string {
+	if x != nil {
+		return x.ApiFramework
+	}
+	return ""
+}
+
+func (x *Creative) GetUniversalAdId() *UniversalAdID {
+	if x != nil {
+		return x.UniversalAdId
+	}
+	return nil
+}
+
+func (x *Creative) GetLinear() *Linear {
+	if x != nil {
+		return x.Linear
+	}
+	return nil
+}
+
+func (x *Creative) GetCompanionAds() *CompanionAds {
+	if x != nil {
+		return x.CompanionAds
+	}
+	return nil
+}
+
+func (x *Creative) GetNonLinearAds() *NonLinearAds {
+	if x != nil {
+		return x.NonLinearAds
+	}
+	return nil
+}
+
+func (x *Creative) GetCreativeExtensions() *Extensions {
+	if x != nil {
+		return x.CreativeExtensions
+	}
+	return nil
+}
+
+type CreativeWrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sequence     int64                `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	AdId         string               `protobuf:"bytes,3,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	Linear       *LinearWrapper       `protobuf:"bytes,4,opt,name=linear,proto3" json:"linear,omitempty"`
+	CompanionAds *CompanionAdsWrapper `protobuf:"bytes,5,opt,name=companion_ads,json=companionAds,proto3" json:"companion_ads,omitempty"`
+	NonLinearAds *NonLinearAdsWrapper `protobuf:"bytes,6,opt,name=non_linear_ads,json=nonLinearAds,proto3" json:"non_linear_ads,omitempty"`
+}
+
+func (x *CreativeWrapper) Reset() {
+	*x = CreativeWrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreativeWrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreativeWrapper) ProtoMessage() {}
+
+func (x *CreativeWrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreativeWrapper.ProtoReflect.Descriptor instead.
+func (*CreativeWrapper) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreativeWrapper) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreativeWrapper) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *CreativeWrapper) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *CreativeWrapper) GetLinear() *LinearWrapper {
+	if x != nil {
+		return x.Linear
+	}
+	return nil
+}
+
+func (x *CreativeWrapper) GetCompanionAds() *CompanionAdsWrapper {
+	if x != nil {
+		return x.CompanionAds
+	}
+	return nil
+}
+
+func (x *CreativeWrapper) GetNonLinearAds() *NonLinearAdsWrapper {
+	if x != nil {
+		return x.NonLinearAds
+	}
+	return nil
+}
+
+type UniversalAdID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdRegistry string `protobuf:"bytes,1,opt,name=id_registry,json=idRegistry,proto3" json:"id_registry,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UniversalAdID) Reset() {
+	*x = UniversalAdID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniversalAdID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniversalAdID) ProtoMessage() {}
+
+func (x *UniversalAdID) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniversalAdID.ProtoReflect.Descriptor instead.
+func (*UniversalAdID) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UniversalAdID) GetIdRegistry() string {
+	if x != nil {
+		return x.IdRegistry
+	}
+	return ""
+}
+
+func (x *UniversalAdID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Linear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SkipOffset     *Offset       `protobuf:"bytes,1,opt,name=skip_offset,json=skipOffset,proto3" json:"skip_offset,omitempty"`
+	Icons          *Icons        `protobuf:"bytes,2,opt,name=icons,proto3" json:"icons,omitempty"`
+	TrackingEvents []*Tracking   `protobuf:"bytes,3,rep,name=tracking_events,json=trackingEvents,proto3" json:"tracking_events,omitempty"`
+	AdParameters   *AdParameters `protobuf:"bytes,4,opt,name=ad_parameters,json=adParameters,proto3" json:"ad_parameters,omitempty"`
+	Duration       int64         `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	MediaFiles     []*MediaFile  `protobuf:"bytes,6,rep,name=media_files,json=mediaFiles,proto3" json:"media_files,omitempty"`
+	VideoClicks    *VideoClicks  `protobuf:"bytes,7,opt,name=video_clicks,json=videoClicks,proto3" json:"video_clicks,omitempty"`
+}
+
+func (x *Linear) Reset() {
+	*x = Linear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Linear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Linear) ProtoMessage() {}
+
+func (x *Linear) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Linear.ProtoReflect.Descriptor instead.
+func (*Linear) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Linear) GetSkipOffset() *Offset {
+	if x != nil {
+		return x.SkipOffset
+	}
+	return nil
+}
+
+func (x *Linear) GetIcons() *Icons {
+	if x != nil {
+		return x.Icons
+	}
+	return nil
+}
+
+func (x *Linear) GetTrackingEvents() []*Tracking {
+	if x != nil {
+		return x.TrackingEvents
+	}
+	return nil
+}
+
+func (x *Linear) GetAdParameters() *AdParameters {
+	if x != nil {
+		return x.AdParameters
+	}
+	return nil
+}
+
+func (x *Linear) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Linear) GetMediaFiles() []*MediaFile {
+	if x != nil {
+		return x.MediaFiles
+	}
+	return nil
+}
+
+func (x *Linear) GetVideoClicks() *VideoClicks {
+	if x != nil {
+		return x.VideoClicks
+	}
+	return nil
+}
+
+type LinearWrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Icons          *Icons       `protobuf:"bytes,1,opt,name=icons,proto3" json:"icons,omitempty"`
+	TrackingEvents []*Tracking  `protobuf:"bytes,2,rep,name=tracking_events,json=trackingEvents,proto3" json:"tracking_events,omitempty"`
+	VideoClicks    *VideoClicks `protobuf:"bytes,3,opt,name=video_clicks,json=videoClicks,proto3" json:"video_clicks,omitempty"`
+}
+
+func (x *LinearWrapper) Reset() {
+	*x = LinearWrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinearWrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinearWrapper) ProtoMessage() {}
+
+func (x *LinearWrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinearWrapper.ProtoReflect.Descriptor instead.
+func (*LinearWrapper) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LinearWrapper) GetIcons() *Icons {
+	if x != nil {
+		return x.Icons
+	}
+	return nil
+}
+
+func (x *LinearWrapper) GetTrackingEvents() []*Tracking {
+	if x != nil {
+		return x.TrackingEvents
+	}
+	return nil
+}
+
+func (x *LinearWrapper) GetVideoClicks() *VideoClicks {
+	if x != nil {
+		return x.VideoClicks
+	}
+	return nil
+}
+
+type CompanionAds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Required   string       `protobuf:"bytes,1,opt,name=required,proto3" json:"required,omitempty"`
+	Companions []*Companion `protobuf:"bytes,2,rep,name=companions,proto3" json:"companions,omitempty"`
+}
+
+func (x *CompanionAds) Reset() {
+	*x = CompanionAds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompanionAds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionAds) ProtoMessage() {}
+
+func (x *CompanionAds) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionAds.ProtoReflect.Descriptor instead.
+func (*CompanionAds) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompanionAds) GetRequired() string {
+	if x != nil {
+		return x.Required
+	}
+	return ""
+}
+
+func (x *CompanionAds) GetCompanions() []*Companion {
+	if x != nil {
+		return x.Companions
+	}
+	return nil
+}
+
+type CompanionAdsWrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Required   string              `protobuf:"bytes,1,opt,name=required,proto3" json:"required,omitempty"`
+	Companions []*CompanionWrapper `protobuf:"bytes,2,rep,name=companions,proto3" json:"companions,omitempty"`
+}
+
+func (x *CompanionAdsWrapper) Reset() {
+	*x = CompanionAdsWrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompanionAdsWrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionAdsWrapper) ProtoMessage() {}
+
+func (x *CompanionAdsWrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionAdsWrapper.ProtoReflect.Descriptor instead.
+func (*CompanionAdsWrapper) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CompanionAdsWrapper) GetRequired() string {
+	if x != nil {
+		return x.Required
+	}
+	return ""
+}
+
+func (x *CompanionAdsWrapper) GetCompanions() []*CompanionWrapper {
+	if x != nil {
+		return x.Companions
+	}
+	return nil
+}
+
+type Companion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width                   int64            `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height                  int64            `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	AssetWidth              int64            `protobuf:"varint,4,opt,name=asset_width,json=assetWidth,proto3" json:"asset_width,omitempty"`
+	AssetHeight             int64            `protobuf:"varint,5,opt,name=asset_height,json=assetHeight,proto3" json:"asset_height,omitempty"`
+	ExpandedWidth           int64            `protobuf:"varint,6,opt,name=expanded_width,json=expandedWidth,proto3" json:"expanded_width,omitempty"`
+	ExpandedHeight          int64            `protobuf:"varint,7,opt,name=expanded_height,json=expandedHeight,proto3" json:"expanded_height,omitempty"`
+	ApiFramework            string           `protobuf:"bytes,8,opt,name=api_framework,json=apiFramework,proto3" json:"api_framework,omitempty"`
+	AdSlotId                string           `protobuf:"bytes,9,opt,name=ad_slot_id,json=adSlotId,proto3" json:"ad_slot_id,omitempty"`
+	PxRatio                 float64          `protobuf:"fixed64,10,opt,name=px_ratio,json=pxRatio,proto3" json:"px_ratio,omitempty"`
+	RenderingMode           string           `protobuf:"bytes,11,opt,name=rendering_mode,json=renderingMode,proto3" json:"rendering_mode,omitempty"`
+	HtmlResource            *HTMLResource    `protobuf:"bytes,12,opt,name=html_resource,json=htmlResource,proto3" json:"html_resource,omitempty"`
+	IframeResource          *string          `protobuf:"bytes,13,opt,name=iframe_resource,json=iframeResource,proto3,oneof" json:"iframe_resource,omitempty"`
+	StaticResource          *StaticResource  `protobuf:"bytes,14,opt,name=static_resource,json=staticResource,proto3" json:"static_resource,omitempty"`
+	AdParameters            *AdParameters    `protobuf:"bytes,15,opt,name=ad_parameters,json=adParameters,proto3" json:"ad_parameters,omitempty"`
+	AltText                 string           `protobuf:"bytes,16,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	CompanionClickThrough   *string          `protobuf:"bytes,17,opt,name=companion_click_through,json=companionClickThrough,proto3,oneof" json:"companion_click_through,omitempty"`
+	CompanionClickTrackings []*ClickTracking `protobuf:"bytes,18,rep,name=companion_click_trackings,json=companionClickTrackings,proto3" json:"companion_click_trackings,omitempty"`
+	TrackingEvents          []*Tracking      `protobuf:"bytes,19,rep,name=tracking_events,json=trackingEvents,proto3" json:"tracking_events,omitempty"`
+	CreativeExtensions      *Extensions      `protobuf:"bytes,20,opt,name=creative_extensions,json=creativeExtensions,proto3" json:"creative_extensions,omitempty"`
+}
+
+func (x *Companion) Reset() {
+	*x = Companion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Companion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Companion) ProtoMessage() {}
+
+func (x *Companion) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Companion.ProtoReflect.Descriptor instead.
+func (*Companion) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Companion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Companion) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Companion) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Companion) GetAssetWidth() int64 {
+	if x != nil {
+		return x.AssetWidth
+	}
+	return 0
+}
+
+func (x *Companion) GetAssetHeight() int64 {
+	if x != nil {
+		return x.AssetHeight
+	}
+	return 0
+}
+
+func (x *Companion) GetExpandedWidth() int64 {
+	if x != nil {
+		return x.ExpandedWidth
+	}
+	return 0
+}
+
+func (x *Companion) GetExpandedHeight() int64 {
+	if x != nil {
+		return x.ExpandedHeight
+	}
+	return 0
+}
+
+func (x *Companion) GetApiFramework() string {
+	if x != nil {
+		return x.ApiFramework
+	}
+	return ""
+}
+
+func (x *Companion) GetAdSlotId() string {
+	if x != nil {
+		return x.AdSlotId
+	}
+	return ""
+}
+
+func (x *Companion) GetPxRatio() float64 {
+	if x != nil {
+		return x.PxRatio
+	}
+	return 0
+}
+
+func (x *Companion) GetRenderingMode() string {
+	if x != nil {
+		return x.RenderingMode
+	}
+	return ""
+}
+
+func (x *Companion) GetHtmlResource() *HTMLResource {
+	if x != nil {
+		return x.HtmlResource
+	}
+	return nil
+}
+
+func (x *Companion) GetIframeResource() string {
+	if x != nil && x.IframeResource != nil {
+		return *x.IframeResource
+	}
+	return ""
+}
+
+func (x *Companion) GetStaticResource() *StaticResource {
+	if x != nil {
+		return x.StaticResource
+	}
+	return nil
+}
+
+func (x *Companion) GetAdParameters() *AdParameters {
+	if x != nil {
+		return x.AdParameters
+	}
+	return nil
+}
+
+func (x *Companion) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *Companion) GetCompanionClickThrough() string {
+	if x != nil && x.CompanionClickThrough != nil {
+		return *x.CompanionClickThrough
+	}
+	return ""
+}
+
+func (x *Companion) GetCompanionClickTrackings() []*ClickTracking {
+	if x != nil {
+		return x.CompanionClickTrackings
+	}
+	return nil
+}
+
+func (x *Companion) GetTrackingEvents() []*Tracking {
+	if x != nil {
+		return x.TrackingEvents
+	}
+	return nil
+}
+
+func (x *Companion) GetCreativeExtensions() *Extensions {
+	if x != nil {
+		return x.CreativeExtensions
+	}
+	return nil
+}
+
+type CompanionWrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width                  int64            `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height                 int64            `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	AssetWidth             int64            `protobuf:"varint,4,opt,name=asset_width,json=assetWidth,proto3" json:"asset_width,omitempty"`
+	AssetHeight            int64            `protobuf:"varint,5,opt,name=asset_height,json=assetHeight,proto3" json:"asset_height,omitempty"`
+	ExpandedWidth          int64            `protobuf:"varint,6,opt,name=expanded_width,json=expandedWidth,proto3" json:"expanded_width,omitempty"`
+	ExpandedHeight         int64            `protobuf:"varint,7,opt,name=expanded_height,json=expandedHeight,proto3" json:"expanded_height,omitempty"`
+	ApiFramework           string           `protobuf:"bytes,8,opt,name=api_framework,json=apiFramework,proto3" json:"api_framework,omitempty"`
+	AdSlotId               string           `protobuf:"bytes,9,opt,name=ad_slot_id,json=adSlotId,proto3" json:"ad_slot_id,omitempty"`
+	PxRatio                float64          `protobuf:"fixed64,10,opt,name=px_ratio,json=pxRatio,proto3" json:"px_ratio,omitempty"`
+	RenderingMode          string           `protobuf:"bytes,11,opt,name=rendering_mode,json=renderingMode,proto3" json:"rendering_mode,omitempty"`
+	CompanionClickThrough  *string          `protobuf:"bytes,12,opt,name=companion_click_through,json=companionClickThrough,proto3,oneof" json:"companion_click_through,omitempty"`
+	CompanionClickTracking []*ClickTracking `protobuf:"bytes,13,rep,name=companion_click_tracking,json=companionClickTracking,proto3" json:"companion_click_tracking,omitempty"`
+	AltText                string           `protobuf:"bytes,14,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	TrackingEvents         []*Tracking      `protobuf:"bytes,15,rep,name=tracking_events,json=trackingEvents,proto3" json:"tracking_events,omitempty"`
+	AdParameters           *AdParameters    `protobuf:"bytes,16,opt,name=ad_parameters,json=adParameters,proto3" json:"ad_parameters,omitempty"`
+	StaticResource         *StaticResource  `protobuf:"bytes,17,opt,name=static_resource,json=staticResource,proto3" json:"static_resource,omitempty"`
+	IframeResource         *string          `protobuf:"bytes,18,opt,name=iframe_resource,json=iframeResource,proto3,oneof" json:"iframe_resource,omitempty"`
+	HtmlResource           *HTMLResource    `protobuf:"bytes,19,opt,name=html_resource,json=htmlResource,proto3" json:"html_resource,omitempty"`
+	CreativeExtensions     *Extensions      `protobuf:"bytes,20,opt,name=creative_extensions,json=creativeExtensions,proto3" json:"creative_extensions,omitempty"`
+}
+
+func (x *CompanionWrapper) Reset() {
+	*x = CompanionWrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompanionWrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionWrapper) ProtoMessage() {}
+
+func (x *CompanionWrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionWrapper.ProtoReflect.Descriptor instead.
+func (*CompanionWrapper) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompanionWrapper) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompanionWrapper) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CompanionWrapper) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CompanionWrapper) GetAssetWidth() int64 {
+	if x != nil {
+		return x.AssetWidth
+	}
+	return 0
+}
+
+func (x *CompanionWrapper) GetAssetHeight() int64 {
+	if x != nil {
+		return x.AssetHeight
+	}
+	return 0
+}
+
+func (x *CompanionWrapper) GetExpandedWidth() int64 {
+	if x != nil {
+		return x.ExpandedWidth
+	}
+	return 0
+}
+
+func (x *CompanionWrapper) GetExpandedHeight() int64 {
+	if x != nil {
+		return x.ExpandedHeight
+	}
+	return 0
+}
+
+func (x *CompanionWrapper) GetApiFramework() string {
+	if x != nil {
+		return x.ApiFramework
+	}
+	return ""
+}
+
+func (x *CompanionWrapper) GetAdSlotId() string {
+	if x != nil {
+		return x.AdSlotId
+	}
+	return ""
+}
+
+func (x *CompanionWrapper) GetPxRatio() float64 {
+	if x != nil {
+		return x.PxRatio
+	}
+	return 0
+}
+
+func (x *CompanionWrapper) GetRenderingMode() string {
+	if x != nil {
+		return x.RenderingMode
+	}
+	return ""
+}
+
+func (x *CompanionWrapper) GetCompanionClickThrough() string {
+	if x != nil && x.CompanionClickThrough != nil {
+		return *x.CompanionClickThrough
+	}
+	return ""
+}
+
+func (x *CompanionWrapper) GetCompanionClickTracking() []*ClickTracking {
+	if x != nil {
+		return x.CompanionClickTracking
+	}
+	return nil
+}
+
+func (x *CompanionWrapper) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *CompanionWrapper) GetTrackingEvents() []*Tracking {
+	if x != nil {
+		return x.TrackingEvents
+	}
+	return nil
+}
+
+func (x *CompanionWrapper) GetAdParameters() *AdParameters {
+	if x != nil {
+		return x.AdParameters
+	}
+	return nil
+}
+
+func (x *CompanionWrapper) GetStaticResource() *StaticResource {
+	if x != nil {
+		return x.StaticResource
+	}
+	return nil
+}
+
+func (x *CompanionWrapper) GetIframeResource() string {
+	if x != nil && x.IframeResource != nil {
+		return *x.IframeResource
+	}
+	return ""
+}
+
+func (x *CompanionWrapper) GetHtmlResource() *HTMLResource {
+	if x != nil {
+		return x.HtmlResource
+	}
+	return nil
+}
+
+func (x *CompanionWrapper) GetCreativeExtensions() *Extensions {
+	if x != nil {
+		return x.CreativeExtensions
+	}
+	return nil
+}
+
+type NonLinearAds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackingEvents []*Tracking  `protobuf:"bytes,1,rep,name=tracking_events,json=trackingEvents,proto3" json:"tracking_events,omitempty"`
+	NonLinears     []*NonLinear `protobuf:"bytes,2,rep,name=non_linears,json=nonLinears,proto3" json:"non_linears,omitempty"`
+}
+
+func (x *NonLinearAds) Reset() {
+	*x = NonLinearAds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NonLinearAds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonLinearAds) ProtoMessage() {}
+
+func (x *NonLinearAds) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonLinearAds.ProtoReflect.Descriptor instead.
+func (*NonLinearAds) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NonLinearAds) GetTrackingEvents() []*Tracking {
+	if x != nil {
+		return x.TrackingEvents
+	}
+	return nil
+}
+
+func (x *NonLinearAds) GetNonLinears() []*NonLinear {
+	if x != nil {
+		return x.NonLinears
+	}
+	return nil
+}
+
+type NonLinearAdsWrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackingEvents []*Tracking         `protobuf:"bytes,1,rep,name=tracking_events,json=trackingEvents,proto3" json:"tracking_events,omitempty"`
+	NonLinears     []*NonLinearWrapper `protobuf:"bytes,2,rep,name=non_linears,json=nonLinears,proto3" json:"non_linears,omitempty"`
+}
+
+func (x *NonLinearAdsWrapper) Reset() {
+	*x = NonLinearAdsWrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NonLinearAdsWrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonLinearAdsWrapper) ProtoMessage() {}
+
+func (x *NonLinearAdsWrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonLinearAdsWrapper.ProtoReflect.Descriptor instead.
+func (*NonLinearAdsWrapper) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NonLinearAdsWrapper) GetTrackingEvents() []*Tracking {
+	if x != nil {
+		return x.TrackingEvents
+	}
+	return nil
+}
+
+func (x *NonLinearAdsWrapper) GetNonLinears() []*NonLinearWrapper {
+	if x != nil {
+		return x.NonLinears
+	}
+	return nil
+}
+
+type NonLinear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width                   int64            `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height                  int64            `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	ExpandedWidth           int64            `protobuf:"varint,4,opt,name=expanded_width,json=expandedWidth,proto3" json:"expanded_width,omitempty"`
+	ExpandedHeight          int64            `protobuf:"varint,5,opt,name=expanded_height,json=expandedHeight,proto3" json:"expanded_height,omitempty"`
+	Scalable                bool             `protobuf:"varint,6,opt,name=scalable,proto3" json:"scalable,omitempty"`
+	MaintainAspectRatio     bool             `protobuf:"varint,7,opt,name=maintain_aspect_ratio,json=maintainAspectRatio,proto3" json:"maintain_aspect_ratio,omitempty"`
+	MinSuggestedDuration    *int64           `protobuf:"varint,8,opt,name=min_suggested_duration,json=minSuggestedDuration,proto3,oneof" json:"min_suggested_duration,omitempty"`
+	ApiFramework            string           `protobuf:"bytes,9,opt,name=api_framework,json=apiFramework,proto3" json:"api_framework,omitempty"`
+	HtmlResource            *HTMLResource    `protobuf:"bytes,10,opt,name=html_resource,json=htmlResource,proto3" json:"html_resource,omitempty"`
+	IframeResource          *string          `protobuf:"bytes,11,opt,name=iframe_resource,json=iframeResource,proto3,oneof" json:"iframe_resource,omitempty"`
+	StaticResource          *StaticResource  `protobuf:"bytes,12,opt,name=static_resource,json=staticResource,proto3" json:"static_resource,omitempty"`
+	AdParameters            *AdParameters    `protobuf:"bytes,13,opt,name=ad_parameters,json=adParameters,proto3" json:"ad_parameters,omitempty"`
+	NonLinearClickThrough   *string          `protobuf:"bytes,14,opt,name=non_linear_click_through,json=nonLinearClickThrough,proto3,oneof" json:"non_linear_click_through,omitempty"`
+	NonLinearClickTrackings []*ClickTracking `protobuf:"bytes,15,rep,name=non_linear_click_trackings,json=nonLinearClickTrackings,proto3" json:"non_linear_click_trackings,omitempty"`
+}
+
+func (x *NonLinear) Reset() {
+	*x = NonLinear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NonLinear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonLinear) ProtoMessage() {}
+
+func (x *NonLinear) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonLinear.ProtoReflect.Descriptor instead.
+func (*NonLinear) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NonLinear) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NonLinear) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *NonLinear) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *NonLinear) GetExpandedWidth() int64 {
+	if x != nil {
+		return x.ExpandedWidth
+	}
+	return 0
+}
+
+func (x *NonLinear) GetExpandedHeight() int64 {
+	if x != nil {
+		return x.ExpandedHeight
+	}
+	return 0
+}
+
+func (x *NonLinear) GetScalable() bool {
+	if x != nil {
+		return x.Scalable
+	}
+	return false
+}
+
+func (x *NonLinear) GetMaintainAspectRatio() bool {
+	if x != nil {
+		return x.MaintainAspectRatio
+	}
+	return false
+}
+
+func (x *NonLinear) GetMinSuggestedDuration() int64 {
+	if x != nil && x.MinSuggestedDuration != nil {
+		return *x.MinSuggestedDuration
+	}
+	return 0
+}
+
+func (x *NonLinear) GetApiFramework() string {
+	if x != nil {
+		return x.ApiFramework
+	}
+	return ""
+}
+
+func (x *NonLinear) GetHtmlResource() *HTMLResource {
+	if x != nil {
+		return x.HtmlResource
+	}
+	return nil
+}
+
+func (x *NonLinear) GetIframeResource() string {
+	if x != nil && x.IframeResource != nil {
+		return *x.IframeResource
+	}
+	return ""
+}
+
+func (x *NonLinear) GetStaticResource() *StaticResource {
+	if x != nil {
+		return x.StaticResource
+	}
+	return nil
+}
+
+func (x *NonLinear) GetAdParameters() *AdParameters {
+	if x != nil {
+		return x.AdParameters
+	}
+	return nil
+}
+
+func (x *NonLinear) GetNonLinearClickThrough() string {
+	if x != nil && x.NonLinearClickThrough != nil {
+		return *x.NonLinearClickThrough
+	}
+	return ""
+}
+
+func (x *NonLinear) GetNonLinearClickTrackings() []*ClickTracking {
+	if x != nil {
+		return x.NonLinearClickTrackings
+	}
+	return nil
+}
+
+type NonLinearWrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width                  int64       `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height                 int64       `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	ExpandedWidth          int64       `protobuf:"varint,4,opt,name=expanded_width,json=expandedWidth,proto3" json:"expanded_width,omitempty"`
+	ExpandedHeight         int64       `protobuf:"varint,5,opt,name=expanded_height,json=expandedHeight,proto3" json:"expanded_height,omitempty"`
+	Scalable               bool        `protobuf:"varint,6,opt,name=scalable,proto3" json:"scalable,omitempty"`
+	MaintainAspectRatio    bool        `protobuf:"varint,7,opt,name=maintain_aspect_ratio,json=maintainAspectRatio,proto3" json:"maintain_aspect_ratio,omitempty"`
+	MinSuggestedDuration   *int64      `protobuf:"varint,8,opt,name=min_suggested_duration,json=minSuggestedDuration,proto3,oneof" json:"min_suggested_duration,omitempty"`
+	ApiFramework           string      `protobuf:"bytes,9,opt,name=api_framework,json=apiFramework,proto3" json:"api_framework,omitempty"`
+	TrackingEvents         []*Tracking `protobuf:"bytes,10,rep,name=tracking_events,json=trackingEvents,proto3" json:"tracking_events,omitempty"`
+	NonLinearClickTracking []string    `protobuf:"bytes,11,rep,name=non_linear_click_tracking,json=nonLinearClickTracking,proto3" json:"non_linear_click_tracking,omitempty"`
+}
+
+func (x *NonLinearWrapper) Reset() {
+	*x = NonLinearWrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NonLinearWrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonLinearWrapper) ProtoMessage() {}
+
+func (x *NonLinearWrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonLinearWrapper.ProtoReflect.Descriptor instead.
+func (*NonLinearWrapper) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NonLinearWrapper) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NonLinearWrapper) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *NonLinearWrapper) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *NonLinearWrapper) GetExpandedWidth() int64 {
+	if x != nil {
+		return x.ExpandedWidth
+	}
+	return 0
+}
+
+func (x *NonLinearWrapper) GetExpandedHeight() int64 {
+	if x != nil {
+		return x.ExpandedHeight
+	}
+	return 0
+}
+
+func (x *NonLinearWrapper) GetScalable() bool {
+	if x != nil {
+		return x.Scalable
+	}
+	return false
+}
+
+func (x *NonLinearWrapper) GetMaintainAspectRatio() bool {
+	if x != nil {
+		return x.MaintainAspectRatio
+	}
+	return false
+}
+
+func (x *NonLinearWrapper) GetMinSuggestedDuration() int64 {
+	if x != nil && x.MinSuggestedDuration != nil {
+		return *x.MinSuggestedDuration
+	}
+	return 0
+}
+
+func (x *NonLinearWrapper) GetApiFramework() string {
+	if x != nil {
+		return x.ApiFramework
+	}
+	return ""
+}
+
+func (x *NonLinearWrapper) GetTrackingEvents() []*Tracking {
+	if x != nil {
+		return x.TrackingEvents
+	}
+	return nil
+}
+
+func (x *NonLinearWrapper) GetNonLinearClickTracking() []string {
+	if x != nil {
+		return x.NonLinearClickTracking
+	}
+	return nil
+}
+
+type Icons struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Icons []*Icon `protobuf:"bytes,1,rep,name=icons,proto3" json:"icons,omitempty"`
+}
+
+func (x *Icons) Reset() {
+	*x = Icons{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Icons) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Icons) ProtoMessage() {}
+
+func (x *Icons) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Icons.ProtoReflect.Descriptor instead.
+func (*Icons) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Icons) GetIcons() []*Icon {
+	if x != nil {
+		return x.Icons
+	}
+	return nil
+}
+
+type Icon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Program           string          `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	Width             int64           `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height            int64           `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XPosition         string          `protobuf:"bytes,4,opt,name=x_position,json=xPosition,proto3" json:"x_position,omitempty"`
+	YPosition         string          `protobuf:"bytes,5,opt,name=y_position,json=yPosition,proto3" json:"y_position,omitempty"`
+	Offset            *Offset         `protobuf:"bytes,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Duration          *int64          `protobuf:"varint,7,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	ApiFramework      string          `protobuf:"bytes,8,opt,name=api_framework,json=apiFramework,proto3" json:"api_framework,omitempty"`
+	PxRatio           float64         `protobuf:"fixed64,9,opt,name=px_ratio,json=pxRatio,proto3" json:"px_ratio,omitempty"`
+	AltText           string          `protobuf:"bytes,10,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	HoverText         string          `protobuf:"bytes,11,opt,name=hover_text,json=hoverText,proto3" json:"hover_text,omitempty"`
+	HtmlResource      *HTMLResource   `protobuf:"bytes,12,opt,name=html_resource,json=htmlResource,proto3" json:"html_resource,omitempty"`
+	IframeResource    *string         `protobuf:"bytes,13,opt,name=iframe_resource,json=iframeResource,proto3,oneof" json:"iframe_resource,omitempty"`
+	StaticResource    *StaticResource `protobuf:"bytes,14,opt,name=static_resource,json=staticResource,proto3" json:"static_resource,omitempty"`
+	IconClicks        *IconClicks     `protobuf:"bytes,15,opt,name=icon_clicks,json=iconClicks,proto3" json:"icon_clicks,omitempty"`
+	IconViewTrackings []string        `protobuf:"bytes,16,rep,name=icon_view_trackings,json=iconViewTrackings,proto3" json:"icon_view_trackings,omitempty"`
+}
+
+func (x *Icon) Reset() {
+	*x = Icon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Icon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Icon) ProtoMessage() {}
+
+func (x *Icon) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Icon.ProtoReflect.Descriptor instead.
+func (*Icon) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Icon) GetProgram() string {
+	if x != nil {
+		return x.Program
+	}
+	return ""
+}
+
+func (x *Icon) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Icon) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Icon) GetXPosition() string {
+	if x != nil {
+		return x.XPosition
+	}
+	return ""
+}
+
+func (x *Icon) GetYPosition() string {
+	if x != nil {
+		return x.YPosition
+	}
+	return ""
+}
+
+func (x *Icon) GetOffset() *Offset {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+func (x *Icon) GetDuration() int64 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *Icon) GetApiFramework() string {
+	if x != nil {
+		return x.ApiFramework
+	}
+	return ""
+}
+
+func (x *Icon) GetPxRatio() float64 {
+	if x != nil {
+		return x.PxRatio
+	}
+	return 0
+}
+
+func (x *Icon) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *Icon) GetHoverText() string {
+	if x != nil {
+		return x.HoverText
+	}
+	return ""
+}
+
+func (x *Icon) GetHtmlResource() *HTMLResource {
+	if x != nil {
+		return x.HtmlResource
+	}
+	return nil
+}
+
+func (x *Icon) GetIframeResource() string {
+	if x != nil && x.IframeResource != nil {
+		return *x.IframeResource
+	}
+	return ""
+}
+
+func (x *Icon) GetStaticResource() *StaticResource {
+	if x != nil {
+		return x.StaticResource
+	}
+	return nil
+}
+
+func (x *Icon) GetIconClicks() *IconClicks {
+	if x != nil {
+		return x.IconClicks
+	}
+	return nil
+}
+
+func (x *Icon) GetIconViewTrackings() []string {
+	if x != nil {
+		return x.IconViewTrackings
+	}
+	return nil
+}
+
+type IconClicks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IconClickThrough        *string                  `protobuf:"bytes,1,opt,name=icon_click_through,json=iconClickThrough,proto3,oneof" json:"icon_click_through,omitempty"`
+	IconClickTrackings      []*ClickTracking         `protobuf:"bytes,2,rep,name=icon_click_trackings,json=iconClickTrackings,proto3" json:"icon_click_trackings,omitempty"`
+	IconClickFallbackImages *IconClickFallbackImages `protobuf:"bytes,3,opt,name=icon_click_fallback_images,json=iconClickFallbackImages,proto3" json:"icon_click_fallback_images,omitempty"`
+}
+
+func (x *IconClicks) Reset() {
+	*x = IconClicks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IconClicks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IconClicks) ProtoMessage() {}
+
+func (x *IconClicks) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IconClicks.ProtoReflect.Descriptor instead.
+func (*IconClicks) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IconClicks) GetIconClickThrough() string {
+	if x != nil && x.IconClickThrough != nil {
+		return *x.IconClickThrough
+	}
+	return ""
+}
+
+func (x *IconClicks) GetIconClickTrackings() []*ClickTracking {
+	if x != nil {
+		return x.IconClickTrackings
+	}
+	return nil
+}
+
+func (x *IconClicks) GetIconClickFallbackImages() *IconClickFallbackImages {
+	if x != nil {
+		return x.IconClickFallbackImages
+	}
+	return nil
+}
+
+type IconClickFallbackImages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IconClickFallbackImages []*IconClickFallbackImage `protobuf:"bytes,1,rep,name=icon_click_fallback_images,json=iconClickFallbackImages,proto3" json:"icon_click_fallback_images,omitempty"`
+}
+
+func (x *IconClickFallbackImages) Reset() {
+	*x = IconClickFallbackImages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IconClickFallbackImages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IconClickFallbackImages) ProtoMessage() {}
+
+func (x *IconClickFallbackImages) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IconClickFallbackImages.ProtoReflect.Descriptor instead.
+func (*IconClickFallbackImages) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *IconClickFallbackImages) GetIconClickFallbackImages() []*IconClickFallbackImage {
+	if x != nil {
+		return x.IconClickFallbackImages
+	}
+	return nil
+}
+
+type IconClickFallbackImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width          int64           `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height         int64           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	AltText        string          `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	StaticResource *StaticResource `protobuf:"bytes,4,opt,name=static_resource,json=staticResource,proto3" json:"static_resource,omitempty"`
+}
+
+func (x *IconClickFallbackImage) Reset() {
+	*x = IconClickFallbackImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IconClickFallbackImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IconClickFallbackImage) ProtoMessage() {}
+
+func (x *IconClickFallbackImage) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IconClickFallbackImage.ProtoReflect.Descriptor instead.
+func (*IconClickFallbackImage) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *IconClickFallbackImage) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *IconClickFallbackImage) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *IconClickFallbackImage) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *IconClickFallbackImage) GetStaticResource() *StaticResource {
+	if x != nil {
+		return x.StaticResource
+	}
+	return nil
+}
+
+type Tracking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event  string  `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Offset *Offset `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Uri    string  `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	Ua     string  `protobuf:"bytes,4,opt,name=ua,proto3" json:"ua,omitempty"`
+}
+
+func (x *Tracking) Reset() {
+	*x = Tracking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tracking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracking) ProtoMessage() {}
+
+func (x *Tracking) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracking.ProtoReflect.Descriptor instead.
+func (*Tracking) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Tracking) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Tracking) GetOffset() *Offset {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+func (x *Tracking) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *Tracking) GetUa() string {
+	if x != nil {
+		return x.Ua
+	}
+	return ""
+}
+
+type Offset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*Offset_Duration
+	//	*Offset_Percent
+	Value isOffset_Value `protobuf_oneof:"value"`
+}
+
+func (x *Offset) Reset() {
+	*x = Offset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Offset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offset) ProtoMessage() {}
+
+func (x *Offset) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offset.ProtoReflect.Descriptor instead.
+func (*Offset) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *Offset) GetValue() isOffset_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Offset) GetDuration() int64 {
+	if x, ok := x.GetValue().(*Offset_Duration); ok {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Offset) GetPercent() float32 {
+	if x, ok := x.GetValue().(*Offset_Percent); ok {
+		return x.Percent
+	}
+	return 0
+}
+
+type isOffset_Value interface {
+	isOffset_Value()
+}
+
+type Offset_Duration struct {
+	Duration int64 `protobuf:"varint,1,opt,name=duration,proto3,oneof"`
+}
+
+type Offset_Percent struct {
+	Percent float32 `protobuf:"fixed32,2,opt,name=percent,proto3,oneof"`
+}
+
+func (*Offset_Duration) isOffset_Value() {}
+
+func (*Offset_Percent) isOffset_Value() {}
+
+type ClickTracking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *ClickTracking) Reset() {
+	*x = ClickTracking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClickTracking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickTracking) ProtoMessage() {}
+
+func (x *ClickTracking) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickTracking.ProtoReflect.Descriptor instead.
+func (*ClickTracking) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClickTracking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClickTracking) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type StaticResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreativeType string `protobuf:"bytes,1,opt,name=creative_type,json=creativeType,proto3" json:"creative_type,omitempty"`
+	Uri          string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *StaticResource) Reset() {
+	*x = StaticResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaticResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticResource) ProtoMessage() {}
+
+func (x *StaticResource) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticResource.ProtoReflect.Descriptor instead.
+func (*StaticResource) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StaticResource) GetCreativeType() string {
+	if x != nil {
+		return x.CreativeType
+	}
+	return ""
+}
+
+func (x *StaticResource) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type HTMLResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XmlEncoded bool   `protobuf:"varint,1,opt,name=xml_encoded,json=xmlEncoded,proto3" json:"xml_encoded,omitempty"`
+	Html       string `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
+}
+
+func (x *HTMLResource) Reset() {
+	*x = HTMLResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTMLResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTMLResource) ProtoMessage() {}
+
+func (x *HTMLResource) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTMLResource.ProtoReflect.Descriptor instead.
+func (*HTMLResource) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HTMLResource) GetXmlEncoded() bool {
+	if x != nil {
+		return x.XmlEncoded
+	}
+	return false
+}
+
+func (x *HTMLResource) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+type AdParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XmlEncoded bool   `protobuf:"varint,1,opt,name=xml_encoded,json=xmlEncoded,proto3" json:"xml_encoded,omitempty"`
+	Parameters string `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *AdParameters) Reset() {
+	*x = AdParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdParameters) ProtoMessage() {}
+
+func (x *AdParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdParameters.ProtoReflect.Descriptor instead.
+func (*AdParameters) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AdParameters) GetXmlEncoded() bool {
+	if x != nil {
+		return x.XmlEncoded
+	}
+	return false
+}
+
+func (x *AdParameters) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+type VideoClicks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClickTrackings []*ClickTracking `protobuf:"bytes,1,rep,name=click_trackings,json=clickTrackings,proto3" json:"click_trackings,omitempty"`
+	CustomClicks   []*ClickTracking `protobuf:"bytes,2,rep,name=custom_clicks,json=customClicks,proto3" json:"custom_clicks,omitempty"`
+	ClickThroughs  []*ClickTracking `protobuf:"bytes,3,rep,name=click_throughs,json=clickThroughs,proto3" json:"click_throughs,omitempty"`
+}
+
+func (x *VideoClicks) Reset() {
+	*x = VideoClicks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoClicks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoClicks) ProtoMessage() {}
+
+func (x *VideoClicks) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoClicks.ProtoReflect.Descriptor instead.
+func (*VideoClicks) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VideoClicks) GetClickTrackings() []*ClickTracking {
+	if x != nil {
+		return x.ClickTrackings
+	}
+	return nil
+}
+
+func (x *VideoClicks) GetCustomClicks() []*ClickTracking {
+	if x != nil {
+		return x.CustomClicks
+	}
+	return nil
+}
+
+func (x *VideoClicks) GetClickThroughs() []*ClickTracking {
+	if x != nil {
+		return x.ClickThroughs
+	}
+	return nil
+}
+
+type MediaFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Delivery            string `protobuf:"bytes,2,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	Type                string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Codec               string `protobuf:"bytes,4,opt,name=codec,proto3" json:"codec,omitempty"`
+	Bitrate             int64  `protobuf:"varint,5,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	MinBitrate          int64  `protobuf:"varint,6,opt,name=min_bitrate,json=minBitrate,proto3" json:"min_bitrate,omitempty"`
+	MaxBitrate          int64  `protobuf:"varint,7,opt,name=max_bitrate,json=maxBitrate,proto3" json:"max_bitrate,omitempty"`
+	Width               int64  `protobuf:"varint,8,opt,name=width,proto3" json:"width,omitempty"`
+	Height              int64  `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Scalable            bool   `protobuf:"varint,10,opt,name=scalable,proto3" json:"scalable,omitempty"`
+	MaintainAspectRatio bool   `protobuf:"varint,11,opt,name=maintain_aspect_ratio,json=maintainAspectRatio,proto3" json:"maintain_aspect_ratio,omitempty"`
+	ApiFramework        string `protobuf:"bytes,12,opt,name=api_framework,json=apiFramework,proto3" json:"api_framework,omitempty"`
+	Uri                 string `protobuf:"bytes,13,opt,name=uri,proto3" json:"uri,omitempty"`
+	FileSize            int64  `protobuf:"varint,14,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	MediaType           string `protobuf:"bytes,15,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+}
+
+func (x *MediaFile) Reset() {
+	*x = MediaFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vastpb_vast_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaFile) ProtoMessage() {}
+
+func (x *MediaFile) ProtoReflect() protoreflect.Message {
+	mi := &file_vastpb_vast_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaFile.ProtoReflect.Descriptor instead.
+func (*MediaFile) Descriptor() ([]byte, []int) {
+	return file_vastpb_vast_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MediaFile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MediaFile) GetDelivery() string {
+	if x != nil {
+		return x.Delivery
+	}
+	return ""
+}
+
+func (x *MediaFile) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MediaFile) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *MediaFile) GetBitrate() int64 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *MediaFile) GetMinBitrate() int64 {
+	if x != nil {
+		return x.MinBitrate
+	}
+	return 0
+}
+
+func (x *MediaFile) GetMaxBitrate() int64 {
+	if x != nil {
+		return x.MaxBitrate
+	}
+	return 0
+}
+
+func (x *MediaFile) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MediaFile) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MediaFile) GetScalable() bool {
+	if x != nil {
+		return x.Scalable
+	}
+	return false
+}
+
+func (x *MediaFile) GetMaintainAspectRatio() bool {
+	if x != nil {
+		return x.MaintainAspectRatio
+	}
+	return false
+}
+
+func (x *MediaFile) GetApiFramework() string {
+	if x != nil {
+		return x.ApiFramework
+	}
+	return ""
+}
+
+func (x *MediaFile) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MediaFile) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *MediaFile) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+var File_vastpb_vast_proto protoreflect.FileDescriptor
+
+var file_vastpb_vast_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x76, 0x61, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x76, 0x61, 0x73, 0x74, 0x22, 0x7e, 0x0a, 0x04, 0x56, 0x41, 0x53,
+	0x54, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x78,
+	0x6d, 0x6c, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x78, 0x6d, 0x6c, 0x6e,
+	0x73, 0x12, 0x1a, 0x0a, 0x03, 0x61, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x52, 0x03, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x02, 0x41, 0x64,
+	0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x52,
+	0x06, 0x69, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x07, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0x92, 0x04, 0x0a, 0x06, 0x49, 0x6e, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x2b, 0x0a, 0x09, 0x61, 0x64, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x52, 0x08, 0x61, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x61, 0x73, 0x74,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76,
+	0x61, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76,
+	0x61, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x70, 0x72, 0x69,
+	0x63, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x5f, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x73, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x75, 0x72, 0x76,
+	0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x75, 0x72, 0x76,
+	0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12,
+	0x2e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x22, 0xaa, 0x04, 0x0a, 0x07, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x64, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74,
+	0x2e, 0x41, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x61, 0x64, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0b,
+	0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x33, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0f, 0x76, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x64,
+	0x5f, 0x74, 0x61, 0x67, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x76, 0x61, 0x73, 0x74, 0x41, 0x64, 0x54, 0x61, 0x67, 0x55, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x11,
+	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x5f, 0x61,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x4f, 0x6e, 0x4e, 0x6f, 0x41, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x61,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x41, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x41, 0x0a, 0x1a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x18, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x5f, 0x61,
+	0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x73, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x22, 0x38, 0x0a, 0x08, 0x41, 0x64, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x2e, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x22, 0x51, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x3c, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x3d, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2f, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x6c, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x37, 0x0a, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61,
+	0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x89, 0x03, 0x0a, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x70, 0x69, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x3b, 0x0a, 0x0f, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f,
+	0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61,
+	0x73, 0x74, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x41, 0x64, 0x49, 0x44,
+	0x52, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x41, 0x64, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x52, 0x06, 0x6c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x37, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76,
+	0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x73,
+	0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x73, 0x12, 0x38,
+	0x0a, 0x0e, 0x6e, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x61, 0x64, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x41, 0x64, 0x73, 0x52, 0x0c, 0x6e, 0x6f, 0x6e, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x41, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x61,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64,
+	0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x57, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x3e, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52,
+	0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x73, 0x12, 0x3f, 0x0a,
+	0x0e, 0x6e, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x61, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x6e,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x41, 0x64, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x52, 0x0c, 0x6e, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x41, 0x64, 0x73, 0x22, 0x40,
+	0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x41, 0x64, 0x49, 0x44, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xd0, 0x02, 0x0a, 0x06, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x0b, 0x73,
+	0x6b, 0x69, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x0a,
+	0x73, 0x6b, 0x69, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x63,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x61, 0x73, 0x74,
+	0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a,
+	0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x61, 0x64, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x76, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x0c, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0b, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6c, 0x69,
+	0x63, 0x6b, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x57, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x63, 0x6f, 0x6e,
+	0x73, 0x52, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x34, 0x0a, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x0b, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x22, 0x5b, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x69, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61,
+	0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x96, 0x07, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65,
+	0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x78, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61,
+	0x73, 0x74, 0x2e, 0x48, 0x54, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x0c, 0x68, 0x74, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x0a,
+	0x0f, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0f, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x61, 0x64,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x3b,
+	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x63,
+	0x6b, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63,
+	0x6b, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x19, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x43, 0x6c,
+	0x69, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x0f,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x1a, 0x0a, 0x18,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b,
+	0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x22, 0x9b, 0x07, 0x0a, 0x10, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65,
+	0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x78, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x4d, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x16, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x37, 0x0a, 0x0f, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x61, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61,
+	0x73, 0x74, 0x2e, 0x41, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x0c, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a,
+	0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0e, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x0f,
+	0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x68, 0x74,
+	0x6d, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x48, 0x54, 0x4d, 0x4c, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0c, 0x68, 0x74, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75,
+	0x67, 0x68, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x79, 0x0a, 0x0c, 0x4e, 0x6f, 0x6e, 0x4c, 0x69, 0x6e,
+	0x65, 0x61, 0x72, 0x41, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x30, 0x0a, 0x0b, 0x6e, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x6e, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x52, 0x0a, 0x6e, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x73, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x4e, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x41,
+	0x64, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0f, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x6e, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4e,
+	0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52,
+	0x0a, 0x6e, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x73, 0x22, 0x84, 0x06, 0x0a, 0x09,
+	0x4e, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x5f,
+	0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x41, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x39, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x37, 0x0a, 0x0d, 0x68, 0x74, 0x6d, 0x6c, 0x5f,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x48, 0x54, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x0c, 0x68, 0x74, 0x6d, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x69, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d,
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0e, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x37, 0x0a,
+	0x0d, 0x61, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x18, 0x6e, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75,
+	0x67, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x15, 0x6e, 0x6f, 0x6e, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x1a, 0x6e, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x61, 0x72, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x17, 0x6e,
+	0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75,
+	0x67, 0x68, 0x22, 0xdf, 0x03, 0x0a, 0x10, 0x4e, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65,
+	0x64, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65,
+	0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x13, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x41, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x39, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x37, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39,
+	0x0a, 0x19, 0x6e, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x6c, 0x69,
+	0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x16, 0x6e, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6c, 0x69, 0x63,
+	0x6b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x05, 0x49, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a,
+	0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x76,
+	0x61, 0x73, 0x74, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x22,
+	0xf7, 0x04, 0x0a, 0x04, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x78, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x78, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x79, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x5f, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70,
+	0x69, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x78,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x78,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x37, 0x0a, 0x0d, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x48, 0x54,
+	0x4d, 0x4c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0c, 0x68, 0x74, 0x6d, 0x6c,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0e, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x6c,
+	0x69, 0x63, 0x6b, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x61, 0x73,
+	0x74, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x0a, 0x69, 0x63,
+	0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x63, 0x6f, 0x6e,
+	0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x69, 0x63, 0x6f, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xf9, 0x01, 0x0a, 0x0a, 0x49, 0x63,
+	0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x31, 0x0a, 0x12, 0x69, 0x63, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x69, 0x63, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63,
+	0x6b, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x14, 0x69,
+	0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x73, 0x74,
+	0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x12,
+	0x69, 0x63, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x5a, 0x0a, 0x1a, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b,
+	0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x63,
+	0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x17, 0x69, 0x63, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b,
+	0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x68,
+	0x72, 0x6f, 0x75, 0x67, 0x68, 0x22, 0x74, 0x0a, 0x17, 0x49, 0x63, 0x6f, 0x6e, 0x43, 0x6c, 0x69,
+	0x63, 0x6b, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x59, 0x0a, 0x1a, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x66,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x63, 0x6f, 0x6e,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x17, 0x69, 0x63, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x46, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x16,
+	0x49, 0x63, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x3d, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0e,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x68,
+	0x0a, 0x08, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x75, 0x61, 0x22, 0x4b, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x1c, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x00, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x31, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x47, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x22, 0x43, 0x0a, 0x0c, 0x48, 0x54, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x78, 0x6d, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x78, 0x6d, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x22, 0x4f, 0x0a, 0x0c, 0x41, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x78, 0x6d, 0x6c, 0x5f, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x78, 0x6d, 0x6c,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0b, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x63, 0x6b,
+	0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
+	0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76,
+	0x61, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12,
+	0x3a, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x73, 0x74, 0x2e, 0x43,
+	0x6c, 0x69, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x63, 0x6c,
+	0x69, 0x63, 0x6b, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x73, 0x22, 0xae, 0x03, 0x0a, 0x09,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e,
+	0x5f, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6d, 0x69, 0x6e, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x5f, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x41, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x69,
+	0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x70, 0x69, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x42, 0x22, 0x5a, 0x20,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x61, 0x74, 0x74, 0x6f,
+	0x6f, 0x2f, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x73, 0x74, 0x2f, 0x76, 0x61, 0x73, 0x74, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vastpb_vast_proto_rawDescOnce sync.Once
+	file_vastpb_vast_proto_rawDescData = file_vastpb_vast_proto_rawDesc
+)
+
+func file_vastpb_vast_proto_rawDescGZIP() []byte {
+	file_vastpb_vast_proto_rawDescOnce.Do(func() {
+		file_vastpb_vast_proto_rawDescData = protoimpl.X.CompressGZIP(file_vastpb_vast_proto_rawDescData)
+	})
+	return file_vastpb_vast_proto_rawDescData
+}
+
+var file_vastpb_vast_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_vastpb_vast_proto_goTypes = []interface{}{
+	(*VAST)(nil),                    // 0: vast.VAST
+	(*Ad)(nil),                      // 1: vast.Ad
+	(*InLine)(nil),                  // 2: vast.InLine
+	(*Wrapper)(nil),                 // 3: vast.Wrapper
+	(*AdSystem)(nil),                // 4: vast.AdSystem
+	(*Impression)(nil),              // 5: vast.Impression
+	(*Pricing)(nil),                 // 6: vast.Pricing
+	(*Category)(nil),                // 7: vast.Category
+	(*Extensions)(nil),              // 8: vast.Extensions
+	(*Extension)(nil),               // 9: vast.Extension
+	(*Creative)(nil),                // 10: vast.Creative
+	(*CreativeWrapper)(nil),         // 11: vast.CreativeWrapper
+	(*UniversalAdID)(nil),           // 12: vast.UniversalAdID
+	(*Linear)(nil),                  // 13: vast.Linear
+	(*LinearWrapper)(nil),           // 14: vast.LinearWrapper
+	(*CompanionAds)(nil),            // 15: vast.CompanionAds
+	(*CompanionAdsWrapper)(nil),     // 16: vast.CompanionAdsWrapper
+	(*Companion)(nil),               // 17: vast.Companion
+	(*CompanionWrapper)(nil),        // 18: vast.CompanionWrapper
+	(*NonLinearAds)(nil),            // 19: vast.NonLinearAds
+	(*NonLinearAdsWrapper)(nil),     // 20: vast.NonLinearAdsWrapper
+	(*NonLinear)(nil),               // 21: vast.NonLinear
+	(*NonLinearWrapper)(nil),        // 22: vast.NonLinearWrapper
+	(*Icons)(nil),                   // 23: vast.Icons
+	(*Icon)(nil),                    // 24: vast.Icon
+	(*IconClicks)(nil),              // 25: vast.IconClicks
+	(*IconClickFallbackImages)(nil), // 26: vast.IconClickFallbackImages
+	(*IconClickFallbackImage)(nil),  // 27: vast.IconClickFallbackImage
+	(*Tracking)(nil),                // 28: vast.Tracking
+	(*Offset)(nil),                  // 29: vast.Offset
+	(*ClickTracking)(nil),           // 30: vast.ClickTracking
+	(*StaticResource)(nil),          // 31: vast.StaticResource
+	(*HTMLResource)(nil),            // 32: vast.HTMLResource
+	(*AdParameters)(nil),            // 33: vast.AdParameters
+	(*VideoClicks)(nil),             // 34: vast.VideoClicks
+	(*MediaFile)(nil),               // 35: vast.MediaFile
+}
+var file_vastpb_vast_proto_depIdxs = []int32{
+	1,  // 0: vast.VAST.ads:type_name -> vast.Ad
+	2,  // 1: vast.Ad.in_line:type_name -> vast.InLine
+	3,  // 2: vast.Ad.wrapper:type_name -> vast.Wrapper
+	4,  // 3: vast.InLine.ad_system:type_name -> vast.AdSystem
+	8,  // 4: vast.InLine.extensions:type_name -> vast.Extensions
+	5,  // 5: vast.InLine.impressions:type_name -> vast.Impression
+	6,  // 6: vast.InLine.pricing:type_name -> vast.Pricing
+	10, // 7: vast.InLine.creatives:type_name -> vast.Creative
+	7,  // 8: vast.InLine.categories:type_name -> vast.Category
+	4,  // 9: vast.Wrapper.ad_system:type_name -> vast.AdSystem
+	9,  // 10: vast.Wrapper.extensions:type_name -> vast.Extension
+	5,  // 11: vast.Wrapper.impressions:type_name -> vast.Impression
+	11, // 12: vast.Wrapper.creatives:type_name -> vast.CreativeWrapper
+	6,  // 13: vast.Wrapper.pricing:type_name -> vast.Pricing
+	9,  // 14: vast.Extensions.extensions:type_name -> vast.Extension
+	28, // 15: vast.Extension.custom_tracking:type_name -> vast.Tracking
+	12, // 16: vast.Creative.universal_ad_id:type_name -> vast.UniversalAdID
+	13, // 17: vast.Creative.linear:type_name -> vast.Linear
+	15, // 18: vast.Creative.companion_ads:type_name -> vast.CompanionAds
+	19, // 19: vast.Creative.non_linear_ads:type_name -> vast.NonLinearAds
+	8,  // 20: vast.Creative.creative_extensions:type_name -> vast.Extensions
+	14, // 21: vast.CreativeWrapper.linear:type_name -> vast.LinearWrapper
+	16, // 22: vast.CreativeWrapper.companion_ads:type_name -> vast.CompanionAdsWrapper
+	20, // 23: vast.CreativeWrapper.non_linear_ads:type_name -> vast.NonLinearAdsWrapper
+	29, // 24: vast.Linear.skip_offset:type_name -> vast.Offset
+	23, // 25: vast.Linear.icons:type_name -> vast.Icons
+	28, // 26: vast.Linear.tracking_events:type_name -> vast.Tracking
+	33, // 27: vast.Linear.ad_parameters:type_name -> vast.AdParameters
+	35, // 28: vast.Linear.media_files:type_name -> vast.MediaFile
+	34, // 29: vast.Linear.video_clicks:type_name -> vast.VideoClicks
+	23, // 30: vast.LinearWrapper.icons:type_name -> vast.Icons
+	28, // 31: vast.LinearWrapper.tracking_events:type_name -> vast.Tracking
+	34, // 32: vast.LinearWrapper.video_clicks:type_name -> vast.VideoClicks
+	17, // 33: vast.CompanionAds.companions:type_name -> vast.Companion
+	18, // 34: vast.CompanionAdsWrapper.companions:type_name -> vast.CompanionWrapper
+	32, // 35: vast.Companion.html_resource:type_name -> vast.HTMLResource
+	31, // 36: vast.Companion.static_resource:type_name -> vast.StaticResource
+	33, // 37: vast.Companion.ad_parameters:type_name -> vast.AdParameters
+	30, // 38: vast.Companion.companion_click_trackings:type_name -> vast.ClickTracking
+	28, // 39: vast.Companion.tracking_events:type_name -> vast.Tracking
+	8,  // 40: vast.Companion.creative_extensions:type_name -> vast.Extensions
+	30, // 41: vast.CompanionWrapper.companion_click_tracking:type_name -> vast.ClickTracking
+	28, // 42: vast.CompanionWrapper.tracking_events:type_name -> vast.Tracking
+	33, // 43: vast.CompanionWrapper.ad_parameters:type_name -> vast.AdParameters
+	31, // 44: vast.CompanionWrapper.static_resource:type_name -> vast.StaticResource
+	32, // 45: vast.CompanionWrapper.html_resource:type_name -> vast.HTMLResource
+	8,  // 46: vast.CompanionWrapper.creative_extensions:type_name -> vast.Extensions
+	28, // 47: vast.NonLinearAds.tracking_events:type_name -> vast.Tracking
+	21, // 48: vast.NonLinearAds.non_linears:type_name -> vast.NonLinear
+	28, // 49: vast.NonLinearAdsWrapper.tracking_events:type_name -> vast.Tracking
+	22, // 50: vast.NonLinearAdsWrapper.non_linears:type_name -> vast.NonLinearWrapper
+	32, // 51: vast.NonLinear.html_resource:type_name -> vast.HTMLResource
+	31, // 52: vast.NonLinear.static_resource:type_name -> vast.StaticResource
+	33, // 53: vast.NonLinear.ad_parameters:type_name -> vast.AdParameters
+	30, // 54: vast.NonLinear.non_linear_click_trackings:type_name -> vast.ClickTracking
+	28, // 55: vast.NonLinearWrapper.tracking_events:type_name -> vast.Tracking
+	24, // 56: vast.Icons.icons:type_name -> vast.Icon
+	29, // 57: vast.Icon.offset:type_name -> vast.Offset
+	32, // 58: vast.Icon.html_resource:type_name -> vast.HTMLResource
+	31, // 59: vast.Icon.static_resource:type_name -> vast.StaticResource
+	25, // 60: vast.Icon.icon_clicks:type_name -> vast.IconClicks
+	30, // 61: vast.IconClicks.icon_click_trackings:type_name -> vast.ClickTracking
+	26, // 62: vast.IconClicks.icon_click_fallback_images:type_name -> vast.IconClickFallbackImages
+	27, // 63: vast.IconClickFallbackImages.icon_click_fallback_images:type_name -> vast.IconClickFallbackImage
+	31, // 64: vast.IconClickFallbackImage.static_resource:type_name -> vast.StaticResource
+	29, // 65: vast.Tracking.offset:type_name -> vast.Offset
+	30, // 66: vast.VideoClicks.click_trackings:type_name -> vast.ClickTracking
+	30, // 67: vast.VideoClicks.custom_clicks:type_name -> vast.ClickTracking
+	30, // 68: vast.VideoClicks.click_throughs:type_name -> vast.ClickTracking
+	69, // [69:69] is the sub-list for method output_type
+	69, // [69:69] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
+}
+
+func init() { file_vastpb_vast_proto_init() }
+func file_vastpb_vast_proto_init() {
+	if File_vastpb_vast_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_vastpb_vast_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VAST); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Wrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdSystem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Impression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pricing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Category); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Extensions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Extension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Creative); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreativeWrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniversalAdID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Linear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinearWrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompanionAds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompanionAdsWrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Companion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompanionWrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NonLinearAds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NonLinearAdsWrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NonLinear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NonLinearWrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Icons); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Icon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IconClicks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IconClickFallbackImages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IconClickFallbackImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tracking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Offset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClickTracking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaticResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTMLResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoClicks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vastpb_vast_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_vastpb_vast_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_vastpb_vast_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_vastpb_vast_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_vastpb_vast_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_vastpb_vast_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_vastpb_vast_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_vastpb_vast_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_vastpb_vast_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_vastpb_vast_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*Offset_Duration)(nil),
+		(*Offset_Percent)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vastpb_vast_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   36,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_vastpb_vast_proto_goTypes,
+		DependencyIndexes: file_vastpb_vast_proto_depIdxs,
+		MessageInfos:      file_vastpb_vast_proto_msgTypes,
+	}.Build()
+	File_vastpb_vast_proto = out.File
+	file_vastpb_vast_proto_rawDesc = nil
+	file_vastpb_vast_proto_goTypes = nil
+	file_vastpb_vast_proto_depIdxs = nil
+}
@@ -0,0 +1,381 @@
+// Package vastrtb turns the bids of OpenRTB 2.x bid responses into VAST ads.
+package vastrtb
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	vast "github.com/zattoo/go-vast"
+)
+
+// DefaultCategoryAuthority is the authority of the categories of the ads when
+// the adapter does not define one.
+const DefaultCategoryAuthority = "https://www.iabtechlab.com/categoryauthority"
+
+// errNoBid is returned when a bid response has no bids.
+var errNoBid = errors.New("vastrtb: bid response has no bid")
+
+// BidResponse is the part of an OpenRTB bid response used to build ads.
+type BidResponse struct {
+	// ID of the bid request to which this is a response.
+	ID string `json:"id"`
+	// The bids, grouped by seat.
+	SeatBid []SeatBid `json:"seatbid,omitempty"`
+	// Bidder generated response ID.
+	BidID string `json:"bidid,omitempty"`
+	// Currency of the bids, USD when empty.
+	Cur string `json:"cur,omitempty"`
+}
+
+// SeatBid is the set of bids of a seat.
+type SeatBid struct {
+	Bid []Bid `json:"bid"`
+	// ID of the buyer seat on whose behalf the bids are made.
+	Seat string `json:"seat,omitempty"`
+}
+
+// Bid is the part of an OpenRTB bid used to build an ad.
+type Bid struct {
+	// Bidder generated bid ID.
+	ID string `json:"id"`
+	// ID of the impression of the bid request the bid is for.
+	ImpID string `json:"impid"`
+	// CPM bid price.
+	Price float64 `json:"price"`
+	// Win notice URL, returning the VAST document when the bid has no markup.
+	NURL string `json:"nurl,omitempty"`
+	// Billing notice URL, requested when the ad is billable.
+	BURL string `json:"burl,omitempty"`
+	// Loss notice URL, requested when the bid is known to have lost.
+	LURL string `json:"lurl,omitempty"`
+	// The VAST document.
+	AdM string `json:"adm,omitempty"`
+	// ID of a preloaded ad.
+	AdID string `json:"adid,omitempty"`
+	// Advertiser domains.
+	ADomain []string `json:"adomain,omitempty"`
+	// Creative ID.
+	CrID string `json:"crid,omitempty"`
+	// IAB content categories of the creative.
+	Cat []string `json:"cat,omitempty"`
+}
+
+// Auction holds the outcome of the auction of a bid, substituted to the
+// ${AUCTION_...} macros of its markup and notice URLs.
+type Auction struct {
+	// ${AUCTION_ID}: ID of the bid request.
+	ID string
+	// ${AUCTION_BID_ID}: ID of the bid response.
+	BidID string
+	// ${AUCTION_SEAT_ID}: ID of the seat of the bid.
+	SeatID string
+	// ${AUCTION_CURRENCY}: currency of the prices, USD when empty.
+	Currency string
+	// ${AUCTION_PRICE}: clearing price, the price of the bid when zero.
+	Price float64
+	// ${AUCTION_MIN_TO_WIN}: minimum bid to win the auction.
+	MinToWin float64
+	// ${AUCTION_LOSS}: loss reason code, for the loss notice.
+	Loss int
+}
+
+func (a *Auction) currency() string {
+	if a.Currency == "" {
+		return "USD"
+	}
+	return a.Currency
+}
+
+func (a *Auction) price(bid *Bid) float64 {
+	if a.Price == 0 {
+		return bid.Price
+	}
+	return a.Price
+}
+
+// Expand substitutes the auction macros of a URL, such as the notice URLs of
+// the bid, written as is or URL-encoded. The values are query escaped, and
+// the macros of the values which are not known are removed.
+func (a *Auction) Expand(uri string, bid *Bid) string {
+	if !strings.Contains(uri, "AUCTION_") {
+		return uri
+	}
+	return expand(uri, a.macros(bid), url.QueryEscape)
+}
+
+// ExpandMarkup substitutes the auction macros of the markup of the bid. The
+// values are escaped for their context: they are query escaped in the URLs,
+// that is the text, attribute values and CDATA sections which start with a
+// URL, and in the URL-encoded macros, XML escaped in the rest of the text and
+// attribute values, and written as is in the rest of the CDATA sections.
+func (a *Auction) ExpandMarkup(markup string, bid *Bid) string {
+	if !strings.Contains(markup, "AUCTION_") {
+		return markup
+	}
+	macros := a.macros(bid)
+	var b strings.Builder
+	for s := markup; s != ""; {
+		switch {
+		case strings.HasPrefix(s, "<![CDATA["):
+			n := len("<![CDATA[")
+			end := strings.Index(s[n:], "]]>")
+			if end < 0 {
+				end = len(s) - n
+			}
+			text := s[n : n+end]
+			escape := cdataEscape
+			if isURL(text) {
+				escape = url.QueryEscape
+			}
+			b.WriteString(s[:n])
+			b.WriteString(expand(text, macros, escape))
+			s = s[n+end:]
+			if strings.HasPrefix(s, "]]>") {
+				b.WriteString("]]>")
+				s = s[3:]
+			}
+		case strings.HasPrefix(s, "<!--"):
+			end := strings.Index(s, "-->")
+			if end < 0 {
+				end = len(s)
+			} else {
+				end += 3
+			}
+			b.WriteString(s[:end])
+			s = s[end:]
+		case s[0] == '<':
+			s = expandTag(&b, s, macros)
+		default:
+			end := strings.IndexByte(s, '<')
+			if end < 0 {
+				end = len(s)
+			}
+			b.WriteString(expand(s[:end], macros, markupEscape(s[:end])))
+			s = s[end:]
+		}
+	}
+	return b.String()
+}
+
+// expandTag writes the tag at the start of s, substituting the macros of its
+// attribute values, and returns the rest of s.
+func expandTag(b *strings.Builder, s string, macros [][2]string) string {
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '>':
+			b.WriteString(s[:i+1])
+			return s[i+1:]
+		case '"', '\'':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				end = len(s) - i - 1
+			}
+			value := s[i+1 : i+1+end]
+			b.WriteString(s[:i+1])
+			b.WriteString(expand(value, macros, markupEscape(value)))
+			s = s[i+1+end:]
+			i = 0
+		}
+	}
+	b.WriteString(s)
+	return ""
+}
+
+// markupEscape returns the escaping of the macros of a text or attribute
+// value.
+func markupEscape(text string) func(string) string {
+	if isURL(text) {
+		return url.QueryEscape
+	}
+	return xmlEscape
+}
+
+func isURL(s string) bool {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "//")
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// cdataEscape splits the ends of CDATA sections found in s.
+func cdataEscape(s string) string {
+	return strings.Replace(s, "]]>", "]]]]><![CDATA[>", -1)
+}
+
+// macros returns the auction macros and their values.
+func (a *Auction) macros(bid *Bid) [][2]string {
+	price := a.price(bid)
+	var mbr, loss, minToWin string
+	if bid.Price > 0 {
+		mbr = formatPrice(price / bid.Price)
+	}
+	if a.Loss != 0 {
+		loss = strconv.Itoa(a.Loss)
+	}
+	if a.MinToWin != 0 {
+		minToWin = formatPrice(a.MinToWin)
+	}
+	return [][2]string{
+		{"AUCTION_ID", a.ID},
+		{"AUCTION_BID_ID", a.BidID},
+		{"AUCTION_IMP_ID", bid.ImpID},
+		{"AUCTION_SEAT_ID", a.SeatID},
+		{"AUCTION_AD_ID", bid.AdID},
+		{"AUCTION_PRICE", formatPrice(price)},
+		{"AUCTION_CURRENCY", a.currency()},
+		{"AUCTION_MBR", mbr},
+		{"AUCTION_LOSS", loss},
+		{"AUCTION_MIN_TO_WIN", minToWin},
+	}
+}
+
+// expand substitutes the macros of s, escaping the values of the macros
+// written as is with escape.
+func expand(s string, macros [][2]string, escape func(string) string) string {
+	if !strings.Contains(s, "AUCTION_") {
+		return s
+	}
+	oldnew := make([]string, 0, 4*len(macros))
+	for _, m := range macros {
+		oldnew = append(oldnew, "${"+m[0]+"}", escape(m[1]), "%24%7B"+m[0]+"%7D", url.QueryEscape(m[1]))
+	}
+	return strings.NewReplacer(oldnew...).Replace(s)
+}
+
+func formatPrice(p float64) string {
+	return strconv.FormatFloat(p, 'f', -1, 64)
+}
+
+// Adapter turns bids into ads.
+type Adapter struct {
+	// The AdSystem of the wrappers created for the bids without markup.
+	AdSystem vast.AdSystem
+	// The authority of the categories of the bids, DefaultCategoryAuthority
+	// when empty.
+	CategoryAuthority string
+}
+
+// Ad returns the ad of a bid: the ad of its markup or, when it has none, a
+// wrapper pointing to its win notice URL. The macros of the markup and of the
+// URLs are expanded first.
+//
+// The clearing price is set as the CPM pricing of the ad and the billing
+// notice URL is added to its impressions. The advertiser domain, the
+// categories and the creative ID of the bid are set on inline ads when they
+// do not define them. The win notice URL, when the bid has markup, and the
+// loss notice URL are left to the exchange, Auction.Expand expanding them.
+func (a *Adapter) Ad(bid *Bid, auction *Auction) (*vast.Ad, error) {
+	if auction == nil {
+		auction = &Auction{}
+	}
+	var ad *vast.Ad
+	if strings.TrimSpace(bid.AdM) == "" {
+		if bid.NURL == "" {
+			return nil, fmt.Errorf("vastrtb: bid %q has neither markup nor win notice URL", bid.ID)
+		}
+		system := a.AdSystem
+		ad = &vast.Ad{Wrapper: &vast.Wrapper{
+			AdSystem:     &system,
+			VASTAdTagURI: vast.CDATAString{CDATA: auction.Expand(bid.NURL, bid)},
+		}}
+	} else {
+		var v vast.VAST
+		if err := vast.Unmarshal([]byte(auction.ExpandMarkup(bid.AdM, bid)), &v); err != nil {
+			return nil, fmt.Errorf("vastrtb: bid %q: %v", bid.ID, err)
+		}
+		if len(v.Ads) != 1 {
+			return nil, fmt.Errorf("vastrtb: bid %q: markup has %d ads instead of 1", bid.ID, len(v.Ads))
+		}
+		ad = &v.Ads[0]
+	}
+	if ad.ID == "" {
+		ad.ID = bid.AdID
+	}
+	if ad.ID == "" {
+		ad.ID = bid.ID
+	}
+
+	pricing := &vast.Pricing{
+		Model:    vast.PricingModelCPM,
+		Currency: auction.currency(),
+		Value:    formatPrice(auction.price(bid)),
+	}
+	var billing []vast.Impression
+	if bid.BURL != "" {
+		billing = append(billing, vast.Impression{URI: auction.Expand(bid.BURL, bid)})
+	}
+	switch {
+	case ad.InLine != nil:
+		ad.InLine.Pricing = pricing
+		ad.InLine.Impressions = append(ad.InLine.Impressions, billing...)
+		a.describe(ad.InLine, bid)
+	case ad.Wrapper != nil:
+		ad.Wrapper.Pricing = pricing
+		ad.Wrapper.Impressions = append(ad.Wrapper.Impressions, billing...)
+	default:
+		return nil, fmt.Errorf("vastrtb: bid %q: ad has neither inline nor wrapper", bid.ID)
+	}
+	return ad, nil
+}
+
+// describe sets the advertiser, categories and creative ID of the bid on an
+// inline ad.
+func (a *Adapter) describe(inline *vast.InLine, bid *Bid) {
+	if inline.Advertiser == "" && len(bid.ADomain) > 0 {
+		inline.Advertiser = bid.ADomain[0]
+	}
+	authority := a.CategoryAuthority
+	if authority == "" {
+		authority = DefaultCategoryAuthority
+	}
+	for _, cat := range bid.Cat {
+		c := vast.Category{Authority: authority, Code: cat}
+		if !hasCategory(inline.Categories, c) {
+			inline.Categories = append(inline.Categories, c)
+		}
+	}
+	if bid.CrID != "" {
+		for i := range inline.Creatives {
+			if inline.Creatives[i].ID == "" {
+				inline.Creatives[i].ID = bid.CrID
+			}
+		}
+	}
+}
+
+func hasCategory(cats []vast.Category, c vast.Category) bool {
+	for _, cat := range cats {
+		if cat.Authority == c.Authority && strings.TrimSpace(cat.Code) == c.Code {
+			return true
+		}
+	}
+	return false
+}
+
+// VAST returns a document holding the ads of the bids of a response, in
+// their order, their clearing price being their bid price.
+func (a *Adapter) VAST(resp *BidResponse, version string) (*vast.VAST, error) {
+	v := &vast.VAST{Version: version}
+	for _, seat := range resp.SeatBid {
+		auction := &Auction{ID: resp.ID, BidID: resp.BidID, SeatID: seat.Seat, Currency: resp.Cur}
+		for i := range seat.Bid {
+			ad, err := a.Ad(&seat.Bid[i], auction)
+			if err != nil {
+				return nil, err
+			}
+			v.Ads = append(v.Ads, *ad)
+		}
+	}
+	if len(v.Ads) == 0 {
+		return nil, errNoBid
+	}
+	return v, nil
+}
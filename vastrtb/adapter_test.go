@@ -0,0 +1,151 @@
+package vastrtb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vast "github.com/zattoo/go-vast"
+)
+
+func TestAuctionExpand(t *testing.T) {
+	bid := &Bid{ID: "b1", ImpID: "1", Price: 2, AdID: "ad1"}
+	auction := &Auction{ID: "req1", BidID: "resp1", SeatID: "seat1", Price: 1.5}
+	assert.Equal(t,
+		"https://example.com/win?p=1.5&c=USD&r=0.75&id=req1&imp=1&seat=seat1&ad=ad1&bid=resp1&loss=",
+		auction.Expand("https://example.com/win?p=${AUCTION_PRICE}&c=${AUCTION_CURRENCY}&r=${AUCTION_MBR}&id=${AUCTION_ID}&imp=${AUCTION_IMP_ID}&seat=${AUCTION_SEAT_ID}&ad=${AUCTION_AD_ID}&bid=${AUCTION_BID_ID}&loss=${AUCTION_LOSS}", bid))
+	assert.Equal(t, "https://example.com/win?p=1.5", auction.Expand("https://example.com/win?p=%24%7BAUCTION_PRICE%7D", bid))
+
+	auction = &Auction{Currency: "EUR", Loss: 102, MinToWin: 2.25}
+	assert.Equal(t, "p=2&c=EUR&loss=102&min=2.25", auction.Expand("p=${AUCTION_PRICE}&c=${AUCTION_CURRENCY}&loss=${AUCTION_LOSS}&min=${AUCTION_MIN_TO_WIN}", bid))
+	assert.Equal(t, "https://example.com/${OTHER}", auction.Expand("https://example.com/${OTHER}", bid))
+
+	// the values are escaped for their context
+	auction = &Auction{ID: "a&b <c>", Currency: "US D"}
+	assert.Equal(t, "https://example.com/win?id=a%26b+%3Cc%3E&c=US+D&e=a%26b+%3Cc%3E",
+		auction.Expand("https://example.com/win?id=${AUCTION_ID}&c=${AUCTION_CURRENCY}&e=%24%7BAUCTION_ID%7D", bid))
+	assert.Equal(t, `<Ad id="a&amp;b &lt;c&gt;"><Impression><![CDATA[https://example.com/imp?id=a%26b+%3Cc%3E]]></Impression></Ad>`,
+		auction.ExpandMarkup(`<Ad id="${AUCTION_ID}"><Impression><![CDATA[https://example.com/imp?id=%24%7BAUCTION_ID%7D]]></Impression></Ad>`, bid))
+
+	assert.Equal(t, `<Impression><![CDATA[https://example.com/imp?id=a%26b+%3Cc%3E&c=US+D]]></Impression>`,
+		auction.ExpandMarkup(`<Impression><![CDATA[https://example.com/imp?id=${AUCTION_ID}&c=${AUCTION_CURRENCY}]]></Impression>`, bid))
+	assert.Equal(t, `<Impression>https://example.com/imp?id=a%26b+%3Cc%3E</Impression><MediaFile type="video/mp4" url=" https://example.com/v?id=a%26b+%3Cc%3E">`,
+		auction.ExpandMarkup(`<Impression>https://example.com/imp?id=${AUCTION_ID}</Impression><MediaFile type="video/mp4" url=" https://example.com/v?id=${AUCTION_ID}">`, bid))
+	assert.Equal(t, `<AdParameters><![CDATA[{"id":"a&b <c>"}]]></AdParameters><!-- ${AUCTION_ID} --><AdTitle>a&amp;b &lt;c&gt;</AdTitle>`,
+		auction.ExpandMarkup(`<AdParameters><![CDATA[{"id":"${AUCTION_ID}"}]]></AdParameters><!-- ${AUCTION_ID} --><AdTitle>${AUCTION_ID}</AdTitle>`, bid))
+	auction.ID = "]]><x>"
+	assert.Equal(t, `<AdParameters><![CDATA[id=]]]]><![CDATA[><x>]]></AdParameters>`,
+		auction.ExpandMarkup(`<AdParameters><![CDATA[id=${AUCTION_ID}]]></AdParameters>`, bid))
+	auction.ID = "a&b <c>"
+
+	bid.AdM = `<VAST version="3.0"><Ad id="${AUCTION_ID}"><InLine><Creatives><Creative><Linear></Linear></Creative></Creatives></InLine></Ad></VAST>`
+	ad, err := (&Adapter{}).Ad(bid, auction)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "a&b <c>", ad.ID)
+	}
+}
+
+func TestAdapterInLine(t *testing.T) {
+	b, err := ioutil.ReadFile("../testdata/vast_inline_linear.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	adm := string(b)
+	bid := &Bid{
+		ID:      "b1",
+		ImpID:   "1",
+		Price:   3.2,
+		AdM:     adm,
+		BURL:    "https://ssp.example.com/bill?p=${AUCTION_PRICE}",
+		ADomain: []string{"advertiser.com"},
+		CrID:    "cr1",
+		Cat:     []string{"IAB1", "IAB2-3"},
+	}
+	a := &Adapter{}
+	ad, err := a.Ad(bid, &Auction{Price: 2.5, Currency: "EUR"})
+	if !assert.NoError(t, err) || !assert.NotNil(t, ad.InLine) {
+		return
+	}
+	assert.Equal(t, "601364", ad.ID)
+	assert.Equal(t, &vast.Pricing{Model: "cpm", Currency: "EUR", Value: "2.5"}, ad.InLine.Pricing)
+	imps := ad.InLine.Impressions
+	if assert.NotEmpty(t, imps) {
+		assert.Equal(t, "https://ssp.example.com/bill?p=2.5", imps[len(imps)-1].URI)
+	}
+	assert.Equal(t, "advertiser.com", ad.InLine.Advertiser)
+	assert.Equal(t, []vast.Category{
+		{Authority: DefaultCategoryAuthority, Code: "IAB1"},
+		{Authority: DefaultCategoryAuthority, Code: "IAB2-3"},
+	}, ad.InLine.Categories)
+	for _, c := range ad.InLine.Creatives {
+		assert.NotEmpty(t, c.ID)
+	}
+
+	// the macros of the markup are expanded
+	bid.AdM = `<VAST version="3.0"><Ad><InLine><Impression><![CDATA[https://example.com/imp?p=${AUCTION_PRICE}]]></Impression><Creatives><Creative><Linear></Linear></Creative></Creatives></InLine></Ad></VAST>`
+	ad, err = a.Ad(bid, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "b1", ad.ID)
+		assert.Equal(t, "https://example.com/imp?p=3.2", ad.InLine.Impressions[0].URI)
+		assert.Equal(t, "3.2", ad.InLine.Pricing.Value)
+		assert.Equal(t, "USD", ad.InLine.Pricing.Currency)
+		assert.Equal(t, "cr1", ad.InLine.Creatives[0].ID)
+	}
+
+	bid.AdM = `<VAST version="3.0"></VAST>`
+	_, err = a.Ad(bid, nil)
+	assert.Error(t, err)
+	bid.AdM = `not xml`
+	_, err = a.Ad(bid, nil)
+	assert.Error(t, err)
+}
+
+func TestAdapterWrapper(t *testing.T) {
+	a := &Adapter{AdSystem: vast.AdSystem{Name: "ssp"}}
+	bid := &Bid{
+		ID:    "b1",
+		Price: 1,
+		NURL:  "https://dsp.example.com/vast?p=${AUCTION_PRICE}",
+		BURL:  "https://dsp.example.com/bill",
+		Cat:   []string{"IAB1"},
+	}
+	ad, err := a.Ad(bid, &Auction{Price: 0.8})
+	if !assert.NoError(t, err) || !assert.NotNil(t, ad.Wrapper) {
+		return
+	}
+	assert.Equal(t, "b1", ad.ID)
+	assert.Equal(t, "ssp", ad.Wrapper.AdSystem.Name)
+	assert.Equal(t, "https://dsp.example.com/vast?p=0.8", ad.Wrapper.VASTAdTagURI.CDATA)
+	assert.Equal(t, &vast.Pricing{Model: "cpm", Currency: "USD", Value: "0.8"}, ad.Wrapper.Pricing)
+	assert.Equal(t, []vast.Impression{{URI: "https://dsp.example.com/bill"}}, ad.Wrapper.Impressions)
+
+	_, err = a.Ad(&Bid{ID: "b2"}, nil)
+	assert.Error(t, err)
+}
+
+func TestAdapterVAST(t *testing.T) {
+	var resp BidResponse
+	err := json.Unmarshal([]byte(`{
+		"id": "req1",
+		"cur": "EUR",
+		"seatbid": [
+			{"seat": "s1", "bid": [{"id": "b1", "impid": "1", "price": 1.25, "nurl": "https://dsp.example.com/vast?seat=${AUCTION_SEAT_ID}"}]},
+			{"seat": "s2", "bid": [{"id": "b2", "impid": "1", "price": 2, "nurl": "https://dsp.example.com/vast?id=${AUCTION_ID}"}]}
+		]
+	}`), &resp)
+	if !assert.NoError(t, err) {
+		return
+	}
+	v, err := (&Adapter{}).VAST(&resp, "4.1")
+	if assert.NoError(t, err) && assert.Len(t, v.Ads, 2) {
+		assert.Equal(t, "4.1", v.Version)
+		assert.Equal(t, "https://dsp.example.com/vast?seat=s1", v.Ads[0].Wrapper.VASTAdTagURI.CDATA)
+		assert.Equal(t, "https://dsp.example.com/vast?id=req1", v.Ads[1].Wrapper.VASTAdTagURI.CDATA)
+		assert.Equal(t, "EUR", v.Ads[1].Wrapper.Pricing.Currency)
+		assert.Equal(t, "2", v.Ads[1].Wrapper.Pricing.Value)
+	}
+
+	_, err = (&Adapter{}).VAST(&BidResponse{ID: "req1"}, "4.1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package vastssai
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SchemeSCTE35 is the scheme of the DASH event streams carrying binary
+// SCTE-35 payloads.
+const SchemeSCTE35 = "urn:scte:scte35:2014:xml+bin"
+
+// Period is a DASH Period of an ad, without its adaptation sets.
+type Period struct {
+	XMLName xml.Name `xml:"Period"`
+	ID      string   `xml:"id,attr"`
+	// The start of the period in the presentation, as an xs:duration.
+	Start string `xml:"start,attr"`
+	// The duration of the period, as an xs:duration.
+	Duration     string        `xml:"duration,attr"`
+	EventStreams []EventStream `xml:"EventStream"`
+}
+
+// EventStream is a DASH EventStream of SCTE-35 signals.
+type EventStream struct {
+	SchemeIDURI string  `xml:"schemeIdUri,attr"`
+	Timescale   uint64  `xml:"timescale,attr"`
+	Events      []Event `xml:"Event"`
+}
+
+// Event is an event of an EventStream, its times being in the timescale of
+// the stream and relative to the start of the period.
+type Event struct {
+	PresentationTime uint64 `xml:"presentationTime,attr"`
+	Duration         uint64 `xml:"duration,attr,omitempty"`
+	ID               uint32 `xml:"id,attr"`
+	Signal           Signal `xml:"http://www.scte.org/schemas/35/2016 Signal"`
+}
+
+// Signal holds a base64 encoded splice_info_section.
+type Signal struct {
+	Binary string `xml:"Binary"`
+}
+
+// DASHPeriods returns a period for each ad of the break, starting at the
+// presentation time of the break. The first period carries the splice_insert
+// of the break and each period the time_signal of the start of its ad.
+func (p *Pod) DASHPeriods() []Period {
+	signals := p.Signals()
+	var periods []Period
+	for i, pl := range p.Placements {
+		stream := EventStream{SchemeIDURI: SchemeSCTE35, Timescale: ticksPerSecond}
+		if i == 0 {
+			stream.Events = append(stream.Events, Event{
+				Duration: ticks(p.Duration),
+				ID:       p.EventID,
+				Signal:   Signal{Binary: p.CueOut().Base64()},
+			})
+		}
+		stream.Events = append(stream.Events, Event{
+			Duration: ticks(pl.Duration),
+			ID:       p.EventID + uint32(i) + 1,
+			// the start of the ads follow the start of the break
+			Signal: Signal{Binary: signals[1+2*i].Base64()},
+		})
+		periods = append(periods, Period{
+			ID:           fmt.Sprintf("%d-%d", p.EventID, i+1),
+			Start:        xsDuration(p.PTS + pl.Offset),
+			Duration:     xsDuration(pl.Duration),
+			EventStreams: []EventStream{stream},
+		})
+	}
+	return periods
+}
+
+// xsDuration formats d as an xs:duration in seconds, such as PT15.5S.
+func xsDuration(d time.Duration) string {
+	s := strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	return "PT" + s + "S"
+}
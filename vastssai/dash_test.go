@@ -0,0 +1,33 @@
+package vastssai
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodDASHPeriods(t *testing.T) {
+	p := testPod(t)
+	periods := p.DASHPeriods()
+	if !assert.Len(t, periods, 2) {
+		return
+	}
+	assert.Equal(t, "PT10S", periods[0].Start)
+	assert.Equal(t, "PT30S", periods[0].Duration)
+	assert.Equal(t, "PT40S", periods[1].Start)
+	assert.Equal(t, "PT16S", periods[1].Duration)
+	assert.Len(t, periods[0].EventStreams[0].Events, 2)
+	assert.Len(t, periods[1].EventStreams[0].Events, 1)
+	assert.Equal(t, p.Signals()[3].Base64(), periods[1].EventStreams[0].Events[0].Signal.Binary)
+
+	out, err := xml.Marshal(periods[1])
+	if assert.NoError(t, err) {
+		assert.Equal(t, `<Period id="100-2" start="PT40S" duration="PT16S">`+
+			`<EventStream schemeIdUri="urn:scte:scte35:2014:xml+bin" timescale="90000">`+
+			`<Event presentationTime="0" duration="1440000" id="102">`+
+			`<Signal xmlns="http://www.scte.org/schemas/35/2016"><Binary>`+p.Signals()[3].Base64()+`</Binary></Signal>`+
+			`</Event></EventStream></Period>`, string(out))
+	}
+	assert.Equal(t, "PT1.5S", xsDuration(1500000000))
+}
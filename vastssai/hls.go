@@ -0,0 +1,69 @@
+package vastssai
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HLSTag is a tag of an HLS media playlist, to be written before the first
+// segment at its offset.
+type HLSTag struct {
+	// The offset of the tag, from the start of the break.
+	Offset time.Duration
+	Tag    string
+}
+
+// HLS returns the tags marking the break, in order: an EXT-X-DATERANGE with
+// the SCTE35-OUT payload and an EXT-X-CUE-OUT at the start of the break, an
+// EXT-X-DATERANGE for each ad, and an EXT-X-DATERANGE with the SCTE35-IN
+// payload and an EXT-X-CUE-IN at the end of the break.
+//
+// The date ranges are only returned when the start date of the break is
+// known. The double quotes and line breaks of the IDs of the ads, which
+// cannot be written in a quoted attribute, are removed.
+func (p *Pod) HLS() []HLSTag {
+	id := strconv.FormatUint(uint64(p.EventID), 10)
+	var tags []HLSTag
+	if !p.StartDate.IsZero() {
+		tags = append(tags, HLSTag{0, fmt.Sprintf(`#EXT-X-DATERANGE:ID="%s",START-DATE="%s",PLANNED-DURATION=%s,SCTE35-OUT=%s`,
+			id, hlsDate(p.StartDate), seconds(p.Duration), p.CueOut().Hex())})
+	}
+	tags = append(tags, HLSTag{0, "#EXT-X-CUE-OUT:DURATION=" + seconds(p.Duration)})
+	if !p.StartDate.IsZero() {
+		for i, pl := range p.Placements {
+			tags = append(tags, HLSTag{pl.Offset, fmt.Sprintf(`#EXT-X-DATERANGE:ID="%s-%d",START-DATE="%s",DURATION=%s,X-AD-ID="%s"`,
+				id, i+1, hlsDate(p.StartDate.Add(pl.Offset)), seconds(pl.Duration), quotable(pl.Ad.ID))})
+		}
+		tags = append(tags, HLSTag{p.Duration, fmt.Sprintf(`#EXT-X-DATERANGE:ID="%s",START-DATE="%s",END-DATE="%s",DURATION=%s,SCTE35-IN=%s`,
+			id, hlsDate(p.StartDate), hlsDate(p.StartDate.Add(p.Duration)), seconds(p.Duration), p.CueIn().Hex())})
+	}
+	return append(tags, HLSTag{p.Duration, "#EXT-X-CUE-IN"})
+}
+
+// HLSCueOutCont returns the EXT-X-CUE-OUT-CONT tag of a segment of the break
+// starting at elapsed.
+func (p *Pod) HLSCueOutCont(elapsed time.Duration) string {
+	return fmt.Sprintf("#EXT-X-CUE-OUT-CONT:ElapsedTime=%s,Duration=%s,SCTE35=%s",
+		seconds(elapsed), seconds(p.Duration), p.CueOut().Base64())
+}
+
+// quotable removes the characters a quoted string attribute cannot hold.
+func quotable(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '"' || r == '\r' || r == '\n' {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func hlsDate(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// seconds formats d as decimal seconds, with milliseconds.
+func seconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
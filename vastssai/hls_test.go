@@ -0,0 +1,43 @@
+package vastssai
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vast "github.com/zattoo/go-vast"
+)
+
+func TestPodHLS(t *testing.T) {
+	p := testPod(t)
+	tags := p.HLS()
+	var lines []string
+	for _, tag := range tags {
+		lines = append(lines, tag.Offset.String()+" "+strings.SplitN(tag.Tag, ",SCTE35-", 2)[0])
+	}
+	assert.Equal(t, []string{
+		`0s #EXT-X-DATERANGE:ID="100",START-DATE="2020-05-17T20:00:00.000Z",PLANNED-DURATION=46.000`,
+		`0s #EXT-X-CUE-OUT:DURATION=46.000`,
+		`0s #EXT-X-DATERANGE:ID="100-1",START-DATE="2020-05-17T20:00:00.000Z",DURATION=30.000,X-AD-ID="601364"`,
+		`30s #EXT-X-DATERANGE:ID="100-2",START-DATE="2020-05-17T20:00:30.000Z",DURATION=16.000,X-AD-ID="20008"`,
+		`46s #EXT-X-DATERANGE:ID="100",START-DATE="2020-05-17T20:00:00.000Z",END-DATE="2020-05-17T20:00:46.000Z",DURATION=46.000`,
+		`46s #EXT-X-CUE-IN`,
+	}, lines)
+	assert.True(t, strings.HasSuffix(tags[0].Tag, ",SCTE35-OUT="+p.CueOut().Hex()))
+	assert.True(t, strings.HasSuffix(tags[4].Tag, ",SCTE35-IN="+p.CueIn().Hex()))
+
+	assert.Equal(t, "#EXT-X-CUE-OUT-CONT:ElapsedTime=12.500,Duration=46.000,SCTE35="+p.CueOut().Base64(), p.HLSCueOutCont(12500*time.Millisecond))
+
+	// the IDs are written as quoted strings
+	p.Placements[0].Ad = &vast.Ad{ID: "a\"b\nc"}
+	assert.Contains(t, p.HLS()[2].Tag, `,X-AD-ID="abc"`)
+
+	// without start date, only the cues are known
+	p.StartDate = time.Time{}
+	tags = p.HLS()
+	if assert.Len(t, tags, 2) {
+		assert.Equal(t, "#EXT-X-CUE-OUT:DURATION=46.000", tags[0].Tag)
+		assert.Equal(t, HLSTag{46 * time.Second, "#EXT-X-CUE-IN"}, tags[1])
+	}
+}
@@ -0,0 +1,198 @@
+// Package vastssai turns the pods of resolved VAST documents into the markers
+// of server-side ad insertion: HLS tags, DASH event streams and SCTE-35
+// payloads, along with the tracking schedules of the ads.
+package vastssai
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	vast "github.com/zattoo/go-vast"
+)
+
+// Slot is an ad of a pod, with the media file chosen to stitch it.
+type Slot struct {
+	// An inline ad with a linear creative.
+	Ad *vast.Ad
+	// A media file of the linear creative, the first linear creative being
+	// used when nil. It may be a copy, such as a media file of a clone of
+	// the ad: it is matched on its URI and type.
+	MediaFile *vast.MediaFile
+}
+
+// Break describes an ad break of a stream.
+type Break struct {
+	// Identifies the splice and segmentation events of the break, the ads
+	// using the following IDs.
+	EventID uint32
+	// The wall clock time of the start of the break, for the HLS date ranges
+	// and the times of the beacons.
+	StartDate time.Time
+	// The presentation time of the start of the break, for the SCTE-35
+	// payloads and the DASH periods.
+	PTS time.Duration
+	// The ads of the break, in order.
+	Slots []Slot
+}
+
+// Placement is an ad placed on the timeline of its break.
+type Placement struct {
+	Slot
+	// The linear creative of the ad.
+	Creative *vast.Creative
+	// The start of the ad, from the start of the break.
+	Offset   time.Duration
+	Duration time.Duration
+	// The tracking schedule of the ad, aligned to the timeline of the break:
+	// the offsets are from the start of the break, and the times are zero
+	// when the start date of the break is unknown.
+	Beacons []vast.Beacon
+}
+
+// Pod is a break placed on its timeline.
+type Pod struct {
+	Break
+	Duration   time.Duration
+	Placements []Placement
+}
+
+// NewPod places the ads of a break one after the other, for the duration of
+// their linear creative.
+func NewPod(b Break) (*Pod, error) {
+	p := &Pod{Break: b}
+	for i, slot := range b.Slots {
+		c, err := linearCreative(slot)
+		if err != nil {
+			return nil, fmt.Errorf("vastssai: ad %d: %v", i, err)
+		}
+		pl := Placement{
+			Slot:     slot,
+			Creative: c,
+			Offset:   p.Duration,
+			Duration: time.Duration(c.Linear.Duration),
+		}
+		pl.Beacons = p.schedule(&pl)
+		p.Placements = append(p.Placements, pl)
+		p.Duration += pl.Duration
+	}
+	return p, nil
+}
+
+// linearCreative returns the creative of the media file of the slot.
+func linearCreative(slot Slot) (*vast.Creative, error) {
+	if slot.Ad == nil || slot.Ad.InLine == nil {
+		return nil, errors.New("not an inline ad")
+	}
+	for i := range slot.Ad.InLine.Creatives {
+		c := &slot.Ad.InLine.Creatives[i]
+		if c.Linear == nil {
+			continue
+		}
+		if slot.MediaFile != nil && !hasMediaFile(c.Linear, slot.MediaFile) {
+			continue
+		}
+		if c.Linear.Duration <= 0 {
+			return nil, errors.New("linear creative without duration")
+		}
+		return c, nil
+	}
+	return nil, errors.New("no linear creative")
+}
+
+func hasMediaFile(l *vast.Linear, mf *vast.MediaFile) bool {
+	uri := strings.TrimSpace(mf.URI)
+	for i := range l.MediaFiles {
+		f := &l.MediaFiles[i]
+		if f == mf || strings.TrimSpace(f.URI) == uri && strings.EqualFold(strings.TrimSpace(f.Type), strings.TrimSpace(mf.Type)) {
+			return true
+		}
+	}
+	return false
+}
+
+// schedule returns the beacons of a placement, aligned to the timeline of the
+// break.
+func (p *Pod) schedule(pl *Placement) []vast.Beacon {
+	beacons := vast.Schedule(pl.Creative.Linear, vast.Duration(pl.Duration), p.StartDate.Add(pl.Offset), pl.Ad.InLine.Impressions)
+	for i := range beacons {
+		beacons[i].Offset += pl.Offset
+		if p.StartDate.IsZero() {
+			beacons[i].Time = time.Time{}
+		}
+	}
+	return beacons
+}
+
+// CueOut returns the splice_insert leaving the network feed at the start of
+// the break, returning to it by itself at the end.
+func (p *Pod) CueOut() *SpliceInfo {
+	return &SpliceInfo{Command: &SpliceInsert{
+		EventID:      p.EventID,
+		OutOfNetwork: true,
+		PTS:          p.PTS,
+		Duration:     p.Duration,
+		AutoReturn:   true,
+	}}
+}
+
+// CueIn returns the splice_insert returning to the network feed at the end
+// of the break.
+func (p *Pod) CueIn() *SpliceInfo {
+	return &SpliceInfo{Command: &SpliceInsert{
+		EventID: p.EventID,
+		PTS:     p.PTS + p.Duration,
+	}}
+}
+
+// Signals returns the time_signals of the break, in order: the start of the
+// break, the start and end of each ad, and the end of the break. The ads
+// are identified by the Ad-ID of their creative when it has one.
+func (p *Pod) Signals() []*SpliceInfo {
+	signal := func(pts time.Duration, d *SegmentationDescriptor) *SpliceInfo {
+		d.DeliveryNotRestricted = true
+		return &SpliceInfo{Command: &TimeSignal{PTS: pts}, Descriptors: []SpliceDescriptor{d}}
+	}
+	signals := []*SpliceInfo{signal(p.PTS, &SegmentationDescriptor{
+		EventID:  p.EventID,
+		Duration: p.Duration,
+		TypeID:   SegmentationBreakStart,
+	})}
+	n := uint8(len(p.Placements))
+	for i, pl := range p.Placements {
+		upidType, upid := adUPID(pl.Creative)
+		start := &SegmentationDescriptor{
+			EventID:          p.EventID + uint32(i) + 1,
+			Duration:         pl.Duration,
+			UPIDType:         upidType,
+			UPID:             upid,
+			TypeID:           SegmentationProviderAdvertisementStart,
+			SegmentNum:       uint8(i) + 1,
+			SegmentsExpected: n,
+		}
+		end := *start
+		end.Duration = 0
+		end.TypeID = SegmentationProviderAdvertisementEnd
+		signals = append(signals,
+			signal(p.PTS+pl.Offset, start),
+			signal(p.PTS+pl.Offset+pl.Duration, &end),
+		)
+	}
+	return append(signals, signal(p.PTS+p.Duration, &SegmentationDescriptor{
+		EventID: p.EventID,
+		TypeID:  SegmentationBreakEnd,
+	}))
+}
+
+// adUPID returns the Ad-ID of a creative as a segmentation UPID.
+func adUPID(c *vast.Creative) (uint8, []byte) {
+	if id := c.UniversalAdID; id != nil {
+		registry := strings.ToLower(strings.TrimSpace(id.IDRegistry))
+		code := strings.TrimSpace(id.ID)
+		if (registry == "ad-id" || registry == "ad-id.org") && len(code) == 12 {
+			return UPIDTypeAdID, []byte(code)
+		}
+	}
+	return UPIDTypeNone, nil
+}
@@ -0,0 +1,142 @@
+package vastssai
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vast "github.com/zattoo/go-vast"
+)
+
+func loadAd(t *testing.T, path string) *vast.Ad {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v vast.VAST
+	if err := vast.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+	return &v.Ads[0]
+}
+
+var startDate = time.Date(2020, 5, 17, 20, 0, 0, 0, time.UTC)
+
+func testPod(t *testing.T) *Pod {
+	first := loadAd(t, "../testdata/vast_inline_linear.xml")
+	second := loadAd(t, "../testdata/vast4_universal_ad_id.xml")
+	c := &second.InLine.Creatives[0]
+	c.UniversalAdID = &vast.UniversalAdID{IDRegistry: "ad-id.org", ID: "ABCD0123000H"}
+	progress, _ := time.ParseDuration("5s")
+	offset := vast.Duration(progress)
+	c.Linear.TrackingEvents = append(c.Linear.TrackingEvents,
+		vast.Tracking{Event: vast.Event_type_progress, Offset: &vast.Offset{Duration: &offset}, URI: "http://example.com/tracking/5s"},
+		vast.Tracking{Event: vast.Event_type_progress, Offset: &vast.Offset{Percent: 0.1}, URI: "http://example.com/tracking/10pct"},
+	)
+	p, err := NewPod(Break{
+		EventID:   100,
+		StartDate: startDate,
+		PTS:       10 * time.Second,
+		Slots: []Slot{
+			{Ad: first},
+			{Ad: second, MediaFile: &c.Linear.MediaFiles[1]},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestNewPod(t *testing.T) {
+	p := testPod(t)
+	assert.Equal(t, 46*time.Second, p.Duration)
+	if !assert.Len(t, p.Placements, 2) {
+		return
+	}
+	assert.Equal(t, time.Duration(0), p.Placements[0].Offset)
+	assert.Equal(t, 30*time.Second, p.Placements[0].Duration)
+	assert.Equal(t, 30*time.Second, p.Placements[1].Offset)
+	assert.Equal(t, 16*time.Second, p.Placements[1].Duration)
+
+	type beacon struct {
+		offset time.Duration
+		event  vast.EventType
+		uris   int
+	}
+	var got []beacon
+	for _, b := range p.Placements[1].Beacons {
+		assert.Equal(t, startDate.Add(b.Offset), b.Time)
+		got = append(got, beacon{b.Offset, b.Event, len(b.URIs)})
+	}
+	assert.Equal(t, []beacon{
+		{30 * time.Second, vast.EventImpression, 1},
+		{30 * time.Second, vast.Event_type_start, 1},
+		{31600 * time.Millisecond, vast.Event_type_progress, 1},
+		{34 * time.Second, vast.Event_type_firstQuartile, 1},
+		{35 * time.Second, vast.Event_type_progress, 1},
+		{38 * time.Second, vast.Event_type_midpoint, 1},
+		{42 * time.Second, vast.Event_type_thirdQuartile, 1},
+		{46 * time.Second, vast.Event_type_complete, 1},
+	}, got)
+	assert.Equal(t, []string{"http://myTrackingURL/impression", "http://myTrackingURL/impression2"}, p.Placements[0].Beacons[0].URIs)
+}
+
+func TestNewPodErrors(t *testing.T) {
+	ad := loadAd(t, "../testdata/vast_inline_linear.xml")
+	other := loadAd(t, "../testdata/vast4_universal_ad_id.xml")
+	for name, slot := range map[string]Slot{
+		"nil ad":        {},
+		"wrapper":       {Ad: &vast.Ad{Wrapper: &vast.Wrapper{}}},
+		"no linear":     {Ad: &vast.Ad{InLine: &vast.InLine{}}},
+		"no duration":   {Ad: &vast.Ad{InLine: &vast.InLine{Creatives: []vast.Creative{{Linear: &vast.Linear{}}}}}},
+		"other's media": {Ad: ad, MediaFile: &other.InLine.Creatives[0].Linear.MediaFiles[0]},
+	} {
+		_, err := NewPod(Break{Slots: []Slot{slot}})
+		assert.Error(t, err, name)
+	}
+}
+
+func TestNewPodClonedMediaFile(t *testing.T) {
+	ad := loadAd(t, "../testdata/vast4_universal_ad_id.xml")
+	clone := ad.Clone()
+	p, err := NewPod(Break{Slots: []Slot{{Ad: ad, MediaFile: &clone.InLine.Creatives[0].Linear.MediaFiles[1]}}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, &ad.InLine.Creatives[0], p.Placements[0].Creative)
+	}
+}
+
+func TestPodSignals(t *testing.T) {
+	p := testPod(t)
+	out := p.CueOut().Command.(*SpliceInsert)
+	assert.True(t, out.OutOfNetwork)
+	assert.Equal(t, 10*time.Second, out.PTS)
+	assert.Equal(t, 46*time.Second, out.Duration)
+	in := p.CueIn().Command.(*SpliceInsert)
+	assert.False(t, in.OutOfNetwork)
+	assert.Equal(t, 56*time.Second, in.PTS)
+
+	signals := p.Signals()
+	if !assert.Len(t, signals, 6) {
+		return
+	}
+	var types []uint8
+	var times []time.Duration
+	for _, s := range signals {
+		types = append(types, s.Descriptors[0].(*SegmentationDescriptor).TypeID)
+		times = append(times, s.Command.(*TimeSignal).PTS)
+	}
+	assert.Equal(t, []uint8{0x22, 0x30, 0x31, 0x30, 0x31, 0x23}, types)
+	assert.Equal(t, []time.Duration{10 * time.Second, 10 * time.Second, 40 * time.Second, 40 * time.Second, 56 * time.Second, 56 * time.Second}, times)
+
+	first := signals[1].Descriptors[0].(*SegmentationDescriptor)
+	assert.Equal(t, UPIDTypeNone, first.UPIDType)
+	second := signals[3].Descriptors[0].(*SegmentationDescriptor)
+	assert.Equal(t, uint32(102), second.EventID)
+	assert.Equal(t, UPIDTypeAdID, second.UPIDType)
+	assert.Equal(t, []byte("ABCD0123000H"), second.UPID)
+	assert.Equal(t, uint8(2), second.SegmentNum)
+	assert.Equal(t, uint8(2), second.SegmentsExpected)
+	assert.NotEmpty(t, signals[3].Encode())
+}
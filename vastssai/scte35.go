@@ -0,0 +1,305 @@
+package vastssai
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+// The SCTE-35 segmentation types used for the pods.
+const (
+	SegmentationBreakStart                 uint8 = 0x22
+	SegmentationBreakEnd                   uint8 = 0x23
+	SegmentationProviderAdvertisementStart uint8 = 0x30
+	SegmentationProviderAdvertisementEnd   uint8 = 0x31
+)
+
+// The SCTE-35 segmentation UPID types used for the pods.
+const (
+	UPIDTypeNone uint8 = 0x00
+	UPIDTypeAdID uint8 = 0x03
+	UPIDTypeTI   uint8 = 0x08
+	UPIDTypeURI  uint8 = 0x0F
+)
+
+// ticksPerSecond is the frequency of the SCTE-35 and MPEG-2 presentation time
+// stamps.
+const ticksPerSecond = 90000
+
+// ticks converts d to 90kHz ticks, rounded.
+func ticks(d time.Duration) uint64 {
+	return uint64(d/time.Second)*ticksPerSecond + uint64((d%time.Second*ticksPerSecond+time.Second/2)/time.Second)
+}
+
+// SpliceInfo is an SCTE-35 splice_info_section, the binary payload of the
+// markers.
+type SpliceInfo struct {
+	// Added to the presentation times of the command, modulo 2^33.
+	PTSAdjustment time.Duration
+	// The authorization tier, 0xFFF when zero.
+	Tier uint16
+	// A *SpliceInsert or a *TimeSignal.
+	Command SpliceCommand
+	// *AvailDescriptor or *SegmentationDescriptor.
+	Descriptors []SpliceDescriptor
+}
+
+// SpliceCommand is the command of a splice_info_section.
+type SpliceCommand interface {
+	commandType() uint8
+	encode(w *bitWriter)
+}
+
+// SpliceDescriptor is a descriptor of a splice_info_section.
+type SpliceDescriptor interface {
+	encode(w *bitWriter)
+}
+
+// SpliceInsert is a splice_insert command, signaling the splice points of an
+// ad break. Only program splices are supported.
+type SpliceInsert struct {
+	EventID uint32
+	// Cancels the event previously sent with EventID.
+	Cancel bool
+	// True when leaving the network feed for the ads, false when returning.
+	OutOfNetwork bool
+	// Splice at the next opportunity instead of at PTS.
+	Immediate bool
+	// The presentation time of the splice point.
+	PTS time.Duration
+	// The duration of the break, omitted when zero.
+	Duration time.Duration
+	// The splicer returns to the network at the end of the break by itself.
+	AutoReturn      bool
+	UniqueProgramID uint16
+	AvailNum        uint8
+	AvailsExpected  uint8
+}
+
+func (*SpliceInsert) commandType() uint8 { return 0x05 }
+
+func (c *SpliceInsert) encode(w *bitWriter) {
+	w.write(uint64(c.EventID), 32)
+	w.flag(c.Cancel)
+	w.ones(7)
+	if c.Cancel {
+		return
+	}
+	w.flag(c.OutOfNetwork)
+	w.flag(true) // program_splice_flag
+	w.flag(c.Duration > 0)
+	w.flag(c.Immediate)
+	w.flag(true) // event_id_compliance_flag
+	w.ones(3)
+	if !c.Immediate {
+		spliceTime(w, c.PTS)
+	}
+	if c.Duration > 0 {
+		w.flag(c.AutoReturn)
+		w.ones(6)
+		w.write(ticks(c.Duration), 33)
+	}
+	w.write(uint64(c.UniqueProgramID), 16)
+	w.write(uint64(c.AvailNum), 8)
+	w.write(uint64(c.AvailsExpected), 8)
+}
+
+// TimeSignal is a time_signal command, its segmentation descriptors telling
+// what starts or ends at its presentation time.
+type TimeSignal struct {
+	// Signal the next opportunity instead of PTS.
+	Immediate bool
+	PTS       time.Duration
+}
+
+func (*TimeSignal) commandType() uint8 { return 0x06 }
+
+func (c *TimeSignal) encode(w *bitWriter) {
+	if c.Immediate {
+		w.flag(false)
+		w.ones(7)
+		return
+	}
+	spliceTime(w, c.PTS)
+}
+
+func spliceTime(w *bitWriter, pts time.Duration) {
+	w.flag(true) // time_specified_flag
+	w.ones(6)
+	w.write(ticks(pts), 33)
+}
+
+// AvailDescriptor is an avail_descriptor, identifying the avail of a
+// splice_insert.
+type AvailDescriptor struct {
+	ProviderAvailID uint32
+}
+
+func (d *AvailDescriptor) encode(w *bitWriter) {
+	w.write(0x00, 8)
+	w.write(8, 8)
+	w.write(cueIdentifier, 32)
+	w.write(uint64(d.ProviderAvailID), 32)
+}
+
+// SegmentationDescriptor is a segmentation_descriptor, telling the segment
+// which starts or ends, such as an ad break or an ad. Only program
+// segmentations are supported.
+type SegmentationDescriptor struct {
+	EventID uint32
+	// Cancels the segmentation event previously sent with EventID.
+	Cancel bool
+	// The duration of the segment, omitted when zero.
+	Duration time.Duration
+	// When false, the delivery of the segment is restricted according to the
+	// flags below.
+	DeliveryNotRestricted bool
+	WebDeliveryAllowed    bool
+	NoRegionalBlackout    bool
+	ArchiveAllowed        bool
+	DeviceRestrictions    uint8
+	// The UPID identifying the segment, such as an Ad-ID.
+	UPIDType uint8
+	UPID     []byte
+	// The segmentation_type_id, such as SegmentationProviderAdvertisementStart.
+	TypeID           uint8
+	SegmentNum       uint8
+	SegmentsExpected uint8
+}
+
+func (d *SegmentationDescriptor) encode(w *bitWriter) {
+	var b bitWriter
+	b.write(cueIdentifier, 32)
+	b.write(uint64(d.EventID), 32)
+	b.flag(d.Cancel)
+	b.ones(7)
+	if !d.Cancel {
+		b.flag(true) // program_segmentation_flag
+		b.flag(d.Duration > 0)
+		b.flag(d.DeliveryNotRestricted)
+		if d.DeliveryNotRestricted {
+			b.ones(5)
+		} else {
+			b.flag(d.WebDeliveryAllowed)
+			b.flag(d.NoRegionalBlackout)
+			b.flag(d.ArchiveAllowed)
+			b.write(uint64(d.DeviceRestrictions), 2)
+		}
+		if d.Duration > 0 {
+			b.write(ticks(d.Duration), 40)
+		}
+		b.write(uint64(d.UPIDType), 8)
+		b.write(uint64(len(d.UPID)), 8)
+		b.bytes(d.UPID)
+		b.write(uint64(d.TypeID), 8)
+		b.write(uint64(d.SegmentNum), 8)
+		b.write(uint64(d.SegmentsExpected), 8)
+	}
+	w.write(0x02, 8)
+	w.write(uint64(len(b.buf)), 8)
+	w.bytes(b.buf)
+}
+
+// cueIdentifier is the "CUEI" identifier of the SCTE-35 descriptors.
+const cueIdentifier = 0x43554549
+
+// Encode returns the binary splice_info_section.
+func (s *SpliceInfo) Encode() []byte {
+	var cmd bitWriter
+	s.Command.encode(&cmd)
+	var descs bitWriter
+	for _, d := range s.Descriptors {
+		d.encode(&descs)
+	}
+	tier := s.Tier
+	if tier == 0 {
+		tier = 0xFFF
+	}
+
+	var body bitWriter
+	body.write(0, 8) // protocol_version
+	body.flag(false) // encrypted_packet
+	body.write(0, 6) // encryption_algorithm
+	body.write(ticks(s.PTSAdjustment), 33)
+	body.write(0xFF, 8) // cw_index, unused
+	body.write(uint64(tier), 12)
+	body.write(uint64(len(cmd.buf)), 12)
+	body.write(uint64(s.Command.commandType()), 8)
+	body.bytes(cmd.buf)
+	body.write(uint64(len(descs.buf)), 16)
+	body.bytes(descs.buf)
+
+	var w bitWriter
+	w.write(0xFC, 8) // table_id
+	w.flag(false)    // section_syntax_indicator
+	w.flag(false)    // private_indicator
+	w.ones(2)        // sap_type: not specified
+	w.write(uint64(len(body.buf)+4), 12)
+	w.bytes(body.buf)
+	w.write(uint64(crc32MPEG2(w.buf)), 32)
+	return w.buf
+}
+
+// Base64 returns the base64 encoded splice_info_section, as used in DASH
+// manifests and in the HLS CUE tags.
+func (s *SpliceInfo) Base64() string {
+	return base64.StdEncoding.EncodeToString(s.Encode())
+}
+
+// Hex returns the hexadecimal splice_info_section, prefixed with 0x, as used
+// in the HLS DATERANGE tags.
+func (s *SpliceInfo) Hex() string {
+	return "0x" + hex.EncodeToString(s.Encode())
+}
+
+// bitWriter writes big-endian bit fields.
+type bitWriter struct {
+	buf  []byte
+	nbit uint
+}
+
+func (w *bitWriter) write(v uint64, n uint) {
+	for i := n; i > 0; i-- {
+		if w.nbit%8 == 0 {
+			w.buf = append(w.buf, 0)
+		}
+		if v>>(i-1)&1 == 1 {
+			w.buf[len(w.buf)-1] |= 0x80 >> (w.nbit % 8)
+		}
+		w.nbit++
+	}
+}
+
+func (w *bitWriter) flag(b bool) {
+	if b {
+		w.write(1, 1)
+	} else {
+		w.write(0, 1)
+	}
+}
+
+func (w *bitWriter) ones(n uint) {
+	w.write(1<<n-1, n)
+}
+
+// bytes appends b, the writer being byte aligned.
+func (w *bitWriter) bytes(b []byte) {
+	w.buf = append(w.buf, b...)
+	w.nbit += uint(len(b)) * 8
+}
+
+// crc32MPEG2 is the CRC-32/MPEG-2 of the splice_info_section.
+func crc32MPEG2(b []byte) uint32 {
+	crc := uint32(0xFFFFFFFF)
+	for _, c := range b {
+		crc ^= uint32(c) << 24
+		for i := 0; i < 8; i++ {
+			if crc&0x80000000 != 0 {
+				crc = crc<<1 ^ 0x04C11DB7
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,64 @@
+package vastssai
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pts converts 90kHz ticks to a duration.
+func pts(ticks uint64) time.Duration {
+	return time.Duration(ticks) * time.Second / ticksPerSecond
+}
+
+func TestTicks(t *testing.T) {
+	for _, n := range []uint64{0, 1, 89999, 90000, 0x07369C02E, 0x0052CCF5, 1<<33 - 1} {
+		assert.Equal(t, n, ticks(pts(n)), n)
+	}
+	assert.Equal(t, uint64(2700000), ticks(30*time.Second))
+}
+
+func TestSpliceInsertEncode(t *testing.T) {
+	// sample 14.2 of SCTE 35 2019
+	s := &SpliceInfo{
+		Command: &SpliceInsert{
+			EventID:      0x4800008F,
+			OutOfNetwork: true,
+			PTS:          pts(0x07369C02E),
+			Duration:     pts(0x0052CCF5),
+			AutoReturn:   true,
+		},
+		Descriptors: []SpliceDescriptor{&AvailDescriptor{ProviderAvailID: 0x00000135}},
+	}
+	assert.Equal(t, "/DAvAAAAAAAA///wFAVIAACPf+/+c2nALv4AUsz1AAAAAAAKAAhDVUVJAAABNWLbowo=", s.Base64())
+	assert.Equal(t, "0xfc302f000000000000fffff014054800008f7feffe7369c02efe0052ccf500000000000a0008435545490000013562dba30a", s.Hex())
+
+	s = &SpliceInfo{Command: &SpliceInsert{EventID: 1, Cancel: true}}
+	b := s.Encode()
+	assert.Equal(t, []byte{0x05, 0x00, 0x00, 0x00, 0x01, 0xFF}, b[13:19])
+}
+
+func TestTimeSignalEncode(t *testing.T) {
+	// sample 14.1 of SCTE 35 2019
+	s := &SpliceInfo{
+		Command: &TimeSignal{PTS: pts(0x072BD0050)},
+		Descriptors: []SpliceDescriptor{&SegmentationDescriptor{
+			EventID:            0x4800008E,
+			Duration:           pts(0x0001A599B0),
+			NoRegionalBlackout: true,
+			ArchiveAllowed:     true,
+			DeviceRestrictions: 3,
+			UPIDType:           UPIDTypeTI,
+			UPID:               []byte{0x00, 0x00, 0x00, 0x00, 0x2C, 0xA0, 0xA1, 0x8A},
+			TypeID:             0x34,
+			SegmentNum:         2,
+		}},
+	}
+	assert.Equal(t, "/DA0AAAAAAAA///wBQb+cr0AUAAeAhxDVUVJSAAAjn/PAAGlmbAICAAAAAAsoKGKNAIAmsnRfg==", s.Base64())
+
+	s = &SpliceInfo{Command: &TimeSignal{Immediate: true}}
+	b, _ := base64.StdEncoding.DecodeString(s.Base64())
+	assert.Equal(t, []byte{0x06, 0x7F, 0x00, 0x00}, b[13:17])
+}
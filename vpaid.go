@@ -0,0 +1,282 @@
+package vast
+
+import "strings"
+
+// CreativeKind classifies a creative by what the player must support to
+// display it.
+type CreativeKind string
+
+const (
+	// Linear creative with media files the player decodes itself.
+	CreativeKindNativeVideo CreativeKind = "native-video"
+	// Linear creative with media files declaring the OMID framework only,
+	// the video being played natively with Open Measurement.
+	CreativeKindOMIDOnly CreativeKind = "omid-only"
+	// VPAID ad unit implemented in JavaScript.
+	CreativeKindVPAIDJS CreativeKind = "vpaid-js"
+	// VPAID ad unit implemented in Flash.
+	CreativeKindVPAIDFlash CreativeKind = "vpaid-flash"
+	// SIMID interactive creative.
+	CreativeKindSIMID CreativeKind = "simid"
+	// Non linear creative displayed natively.
+	CreativeKindNonLinear CreativeKind = "non-linear"
+	// Creative made of companions only.
+	CreativeKindCompanion CreativeKind = "companion"
+	// Creative without any media.
+	CreativeKindEmpty CreativeKind = "empty"
+)
+
+// The apiFramework values.
+const (
+	APIFrameworkVPAID = "VPAID"
+	APIFrameworkSIMID = "SIMID"
+	APIFrameworkOMID  = "OMID"
+)
+
+// CreativeAnalysis describes a creative of an inline ad.
+type CreativeAnalysis struct {
+	Creative *Creative
+	Kind     CreativeKind
+	// The media files of the linear creative which can be played natively,
+	// when the creative is interactive these are the fallbacks for the
+	// players which cannot execute it.
+	Fallbacks []*MediaFile
+}
+
+// Interactive reports whether the creative requires the player to execute a
+// VPAID or SIMID ad unit.
+func (a CreativeAnalysis) Interactive() bool {
+	switch a.Kind {
+	case CreativeKindVPAIDJS, CreativeKindVPAIDFlash, CreativeKindSIMID:
+		return true
+	}
+	return false
+}
+
+// Playable reports whether a player without VPAID and SIMID support can
+// display the creative: it is not interactive or it has fallback media
+// files.
+func (a CreativeAnalysis) Playable() bool {
+	return !a.Interactive() || len(a.Fallbacks) > 0
+}
+
+// Analyze classifies the creative. VPAID is detected from the apiFramework
+// of the media files, or of the creative for the files which are not video
+// or audio, and from the media types, many ad servers only flagging VPAID
+// through type="application/javascript".
+func (c *Creative) Analyze() CreativeAnalysis {
+	a := CreativeAnalysis{Creative: c, Kind: CreativeKindEmpty}
+	switch {
+	case c.Linear != nil && len(c.Linear.MediaFiles) > 0:
+		var vpaidJS, flash, simid, native, omid bool
+		for i := range c.Linear.MediaFiles {
+			mf := &c.Linear.MediaFiles[i]
+			switch k := mediaFileKind(mf.APIFramework, mf.Type, c.APIFramework); k {
+			case CreativeKindVPAIDJS:
+				vpaidJS = true
+			case CreativeKindVPAIDFlash:
+				flash = true
+			case CreativeKindSIMID:
+				simid = true
+			default:
+				a.Fallbacks = append(a.Fallbacks, mf)
+				if k == CreativeKindOMIDOnly {
+					omid = true
+				} else {
+					native = true
+				}
+			}
+		}
+		switch {
+		case vpaidJS:
+			a.Kind = CreativeKindVPAIDJS
+		case flash:
+			a.Kind = CreativeKindVPAIDFlash
+		case simid:
+			a.Kind = CreativeKindSIMID
+		case omid && !native:
+			a.Kind = CreativeKindOMIDOnly
+		default:
+			a.Kind = CreativeKindNativeVideo
+		}
+	case c.NonLinearAds != nil && len(c.NonLinearAds.NonLinears) > 0:
+		a.Kind = CreativeKindNonLinear
+		for _, nl := range c.NonLinearAds.NonLinears {
+			if k := nonLinearKind(&nl, c.APIFramework); k != CreativeKindNonLinear {
+				a.Kind = k
+				break
+			}
+		}
+	case c.CompanionAds != nil && len(c.CompanionAds.Companions) > 0:
+		a.Kind = CreativeKindCompanion
+	}
+	return a
+}
+
+// mediaFileKind classifies a media file from its apiFramework and its MIME
+// type. The video and audio files without apiFramework are the native
+// fallbacks of their creative, the other ones inherit its apiFramework.
+func mediaFileKind(apiFramework, mimeType, creativeAPIFramework string) CreativeKind {
+	if apiFramework == "" && !isNativeMedia(mimeType) {
+		apiFramework = creativeAPIFramework
+	}
+	switch {
+	case isFlash(mimeType):
+		return CreativeKindVPAIDFlash
+	case isJavaScript(mimeType), strings.EqualFold(apiFramework, APIFrameworkVPAID):
+		return CreativeKindVPAIDJS
+	case strings.EqualFold(apiFramework, APIFrameworkSIMID):
+		return CreativeKindSIMID
+	case strings.EqualFold(apiFramework, APIFrameworkOMID):
+		return CreativeKindOMIDOnly
+	}
+	return CreativeKindNativeVideo
+}
+
+// nonLinearKind classifies a non linear ad, VPAID being detected from its
+// apiFramework and the creativeType of its static resource.
+func nonLinearKind(nl *NonLinear, creativeAPIFramework string) CreativeKind {
+	apiFramework := nl.APIFramework
+	if apiFramework == "" {
+		apiFramework = creativeAPIFramework
+	}
+	var creativeType string
+	if nl.StaticResource != nil {
+		creativeType = nl.StaticResource.CreativeType
+	}
+	switch {
+	case isFlash(creativeType) && strings.EqualFold(apiFramework, APIFrameworkVPAID):
+		return CreativeKindVPAIDFlash
+	case strings.EqualFold(apiFramework, APIFrameworkVPAID):
+		return CreativeKindVPAIDJS
+	case strings.EqualFold(apiFramework, APIFrameworkSIMID):
+		return CreativeKindSIMID
+	}
+	return CreativeKindNonLinear
+}
+
+func isJavaScript(mimeType string) bool {
+	switch strings.ToLower(strings.TrimSpace(mimeType)) {
+	case "application/javascript", "application/x-javascript", "text/javascript", "application/ecmascript":
+		return true
+	}
+	return false
+}
+
+// isNativeMedia reports whether the MIME type is the one of a video or audio
+// file, or of a stream, which players decode themselves.
+func isNativeMedia(mimeType string) bool {
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	switch mimeType {
+	case "application/x-mpegurl", "application/vnd.apple.mpegurl", "application/dash+xml":
+		return true
+	}
+	return strings.HasPrefix(mimeType, "video/") || strings.HasPrefix(mimeType, "audio/")
+}
+
+func isFlash(mimeType string) bool {
+	return strings.EqualFold(strings.TrimSpace(mimeType), "application/x-shockwave-flash")
+}
+
+// Analyze classifies the creatives of the inline ad.
+func (inline *InLine) Analyze() []CreativeAnalysis {
+	res := make([]CreativeAnalysis, len(inline.Creatives))
+	for i := range inline.Creatives {
+		res[i] = inline.Creatives[i].Analyze()
+	}
+	return res
+}
+
+// StripVPAID returns a copy of the document for players which cannot execute
+// VPAID: the VPAID media files and non linear ads are removed, along with
+// the AdParameters of the linear creatives which had VPAID media files, and
+// the creatives left without media are removed. Inline ads left without a
+// linear or non linear creative, such as the ones with companions only, are
+// removed too.
+//
+// The ads of v which were removed are returned, whether some ads remain or
+// not: their error URIs should be requested with
+// ErrorCodeMediaFileNotSupported. When the document had ads and none
+// remains, ErrorCodeMediaFileNotSupported is returned too. The wrappers are
+// kept as is, the ads they lead to must be stripped once resolved.
+func StripVPAID(v *VAST) (*VAST, []Ad, error) {
+	res := v.Clone()
+	if res == nil {
+		return nil, nil, nil
+	}
+	var removed []Ad
+	ads := res.Ads[:0]
+	for i, ad := range res.Ads {
+		if ad.InLine == nil {
+			ads = append(ads, ad)
+			continue
+		}
+		if !hasPlayable(ad.InLine.Creatives) {
+			ads = append(ads, ad)
+			continue
+		}
+		creatives := ad.InLine.Creatives[:0]
+		for _, c := range ad.InLine.Creatives {
+			if stripCreativeVPAID(&c) {
+				creatives = append(creatives, c)
+			}
+		}
+		if !hasPlayable(creatives) {
+			removed = append(removed, v.Ads[i])
+			continue
+		}
+		ad.InLine.Creatives = creatives
+		ads = append(ads, ad)
+	}
+	if len(v.Ads) > 0 && len(ads) == 0 {
+		res.Ads = nil
+		return res, removed, ErrorCodeMediaFileNotSupported
+	}
+	res.Ads = ads
+	return res, removed, nil
+}
+
+// hasPlayable reports whether any of the creatives is a linear creative with
+// media files or a non linear one.
+func hasPlayable(creatives []Creative) bool {
+	for _, c := range creatives {
+		if c.Linear != nil && len(c.Linear.MediaFiles) > 0 || c.NonLinearAds != nil && len(c.NonLinearAds.NonLinears) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// stripCreativeVPAID removes the VPAID media of a creative, returning whether
+// the creative should be kept.
+func stripCreativeVPAID(c *Creative) bool {
+	a := c.Analyze()
+	switch a.Kind {
+	case CreativeKindVPAIDJS, CreativeKindVPAIDFlash:
+		if c.Linear != nil && len(c.Linear.MediaFiles) > 0 {
+			if len(a.Fallbacks) == 0 {
+				return false
+			}
+			files := make([]MediaFile, len(a.Fallbacks))
+			for i, mf := range a.Fallbacks {
+				files[i] = *mf
+			}
+			c.Linear.MediaFiles = files
+			c.Linear.AdParameters = nil
+			return true
+		}
+		nls := c.NonLinearAds.NonLinears[:0]
+		for _, nl := range c.NonLinearAds.NonLinears {
+			switch nonLinearKind(&nl, c.APIFramework) {
+			case CreativeKindVPAIDJS, CreativeKindVPAIDFlash:
+			default:
+				nls = append(nls, nl)
+			}
+		}
+		if len(nls) == 0 {
+			return false
+		}
+		c.NonLinearAds.NonLinears = nls
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package vast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreativeAnalyze(t *testing.T) {
+	mp4 := MediaFile{Type: "video/mp4", URI: "https://example.com/ad.mp4"}
+	js := MediaFile{Type: "application/javascript", APIFramework: "VPAID", URI: "https://example.com/vpaid.js"}
+	for name, tt := range map[string]struct {
+		creative  Creative
+		kind      CreativeKind
+		fallbacks int
+	}{
+		"native":                {Creative{Linear: &Linear{MediaFiles: []MediaFile{mp4}}}, CreativeKindNativeVideo, 1},
+		"vpaid js":              {Creative{Linear: &Linear{MediaFiles: []MediaFile{js}}}, CreativeKindVPAIDJS, 0},
+		"vpaid by type":         {Creative{Linear: &Linear{MediaFiles: []MediaFile{{Type: "application/x-javascript"}}}}, CreativeKindVPAIDJS, 0},
+		"vpaid creative":        {Creative{APIFramework: "vpaid", Linear: &Linear{MediaFiles: []MediaFile{{Type: "text/html"}}}}, CreativeKindVPAIDJS, 0},
+		"vpaid creative native": {Creative{APIFramework: "VPAID", Linear: &Linear{MediaFiles: []MediaFile{{Type: "text/html"}, mp4}}}, CreativeKindVPAIDJS, 1},
+		"vpaid fallback":        {Creative{Linear: &Linear{MediaFiles: []MediaFile{js, mp4}}}, CreativeKindVPAIDJS, 1},
+		"flash":                 {Creative{Linear: &Linear{MediaFiles: []MediaFile{{Type: "application/x-shockwave-flash", APIFramework: "VPAID"}, mp4}}}, CreativeKindVPAIDFlash, 1},
+		"simid":                 {Creative{Linear: &Linear{MediaFiles: []MediaFile{{Type: "text/html", APIFramework: "SIMID"}, mp4}}}, CreativeKindSIMID, 1},
+		"omid":                  {Creative{Linear: &Linear{MediaFiles: []MediaFile{{Type: "video/mp4", APIFramework: "omid"}}}}, CreativeKindOMIDOnly, 1},
+		"omid and native":       {Creative{Linear: &Linear{MediaFiles: []MediaFile{{Type: "video/mp4", APIFramework: "OMID"}, mp4}}}, CreativeKindNativeVideo, 2},
+		"non linear":            {Creative{NonLinearAds: &NonLinearAds{NonLinears: []NonLinear{{StaticResource: &StaticResource{CreativeType: "image/png"}}}}}, CreativeKindNonLinear, 0},
+		"non linear vpaid":      {Creative{NonLinearAds: &NonLinearAds{NonLinears: []NonLinear{{APIFramework: "VPAID", StaticResource: &StaticResource{CreativeType: "application/javascript"}}}}}, CreativeKindVPAIDJS, 0},
+		"companion":             {Creative{CompanionAds: &CompanionAds{Companions: []Companion{{}}}}, CreativeKindCompanion, 0},
+		"empty":                 {Creative{Linear: &Linear{}}, CreativeKindEmpty, 0},
+	} {
+		a := tt.creative.Analyze()
+		assert.Equal(t, tt.kind, a.Kind, name)
+		assert.Len(t, a.Fallbacks, tt.fallbacks, name)
+		assert.Equal(t, tt.kind != CreativeKindVPAIDJS || tt.fallbacks > 0, a.Playable(), name)
+	}
+}
+
+func TestAnalyzeFixtures(t *testing.T) {
+	for path, kinds := range map[string][]CreativeKind{
+		"testdata/spotx_vpaid.xml":           {CreativeKindVPAIDJS, CreativeKindCompanion},
+		"testdata/extraspaces_vpaid.xml":     {CreativeKindVPAIDJS},
+		"testdata/vast_inline_linear.xml":    {CreativeKindNativeVideo, CreativeKindCompanion},
+		"testdata/vast_inline_nonlinear.xml": {CreativeKindNonLinear, CreativeKindCompanion},
+	} {
+		v, _, _, err := loadFixture(path)
+		if !assert.NoError(t, err, path) || !assert.NotEmpty(t, v.Ads, path) {
+			continue
+		}
+		var got []CreativeKind
+		for _, a := range v.Ads[0].InLine.Analyze() {
+			got = append(got, a.Kind)
+		}
+		assert.Equal(t, kinds, got, path)
+	}
+}
+
+func TestStripVPAID(t *testing.T) {
+	v, _, _, err := loadFixture("testdata/spotx_vpaid.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the VPAID linear is removed, the companions alone are not playable
+	stripped, removed, err := StripVPAID(v)
+	assert.Equal(t, ErrorCodeMediaFileNotSupported, err)
+	if assert.NotNil(t, stripped) {
+		assert.Empty(t, stripped.Ads)
+	}
+	if assert.Len(t, removed, 1) {
+		assert.Equal(t, v.Ads[0].ID, removed[0].ID)
+	}
+	assert.Len(t, v.Ads[0].InLine.Creatives, 2, "the original is unchanged")
+
+	// the other ads are kept
+	native, _, _, err := loadFixture("testdata/vast_inline_linear.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	v.Ads = append(v.Ads, native.Ads...)
+	stripped, removed, err = StripVPAID(v)
+	if assert.NoError(t, err) && assert.Len(t, stripped.Ads, 1) {
+		assert.Equal(t, "601364", stripped.Ads[0].ID)
+	}
+	// the removed ads are reported even though others remain
+	if assert.Len(t, removed, 1) {
+		assert.Equal(t, v.Ads[0].ID, removed[0].ID)
+	}
+
+	// nothing playable remains
+	v, _, _, err = loadFixture("testdata/extraspaces_vpaid.xml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	stripped, removed, err = StripVPAID(v)
+	assert.Equal(t, ErrorCodeMediaFileNotSupported, err)
+	if assert.NotNil(t, stripped) {
+		assert.Empty(t, stripped.Ads)
+	}
+
+	// the VPAID media files are replaced by their fallbacks
+	v = &VAST{Ads: []Ad{{InLine: &InLine{Creatives: []Creative{{Linear: &Linear{
+		AdParameters: &AdParameters{Parameters: `{"id":1}`},
+		MediaFiles: []MediaFile{
+			{Type: "application/javascript", APIFramework: "VPAID", URI: "https://example.com/vpaid.js"},
+			{Type: "video/mp4", URI: "https://example.com/ad.mp4"},
+		},
+	}}}}}}}
+	stripped, removed, err = StripVPAID(v)
+	assert.Empty(t, removed)
+	if assert.NoError(t, err) {
+		l := stripped.Ads[0].InLine.Creatives[0].Linear
+		assert.Nil(t, l.AdParameters)
+		if assert.Len(t, l.MediaFiles, 1) {
+			assert.Equal(t, "https://example.com/ad.mp4", l.MediaFiles[0].URI)
+		}
+	}
+	assert.Len(t, v.Ads[0].InLine.Creatives[0].Linear.MediaFiles, 2)
+
+	// native documents are unchanged
+	v, _, _, err = loadFixture("testdata/vast_inline_linear.xml")
+	if assert.NoError(t, err) {
+		stripped, removed, err = StripVPAID(v)
+		if assert.NoError(t, err) {
+			assert.True(t, v.Equal(stripped))
+		}
+	}
+	stripped, removed, err = StripVPAID(nil)
+	assert.Nil(t, stripped)
+	assert.Nil(t, removed)
+	assert.NoError(t, err)
+}